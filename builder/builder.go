@@ -0,0 +1,320 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Fluent builder of schemas.
+//
+// created          18-10-2026
+
+// Package builder builds gojsonschema schemas in Go, keyword by keyword.
+package builder
+
+import (
+	"encoding/json"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Builder builds a schema document keyword by keyword, ie
+//
+//	builder.Object().Prop("name", builder.String().MinLength(1)).Required("name")
+//
+// Methods modify the builder and return it, subschemas are builders too.
+// The document is compiled as any other, invalid keyword values being
+// reported by Compile.
+type Builder struct {
+	keywords map[string]interface{}
+}
+
+func newBuilder(types ...string) *Builder {
+	b := &Builder{keywords: map[string]interface{}{}}
+	if len(types) > 0 {
+		b.Type(types...)
+	}
+	return b
+}
+
+// Any returns a builder of a schema without type
+func Any() *Builder {
+	return newBuilder()
+}
+
+// Object returns a builder of an object schema
+func Object() *Builder {
+	return newBuilder(gojsonschema.TYPE_OBJECT)
+}
+
+// Array returns a builder of an array schema
+func Array() *Builder {
+	return newBuilder(gojsonschema.TYPE_ARRAY)
+}
+
+// String returns a builder of a string schema
+func String() *Builder {
+	return newBuilder(gojsonschema.TYPE_STRING)
+}
+
+// Number returns a builder of a number schema
+func Number() *Builder {
+	return newBuilder(gojsonschema.TYPE_NUMBER)
+}
+
+// Integer returns a builder of an integer schema
+func Integer() *Builder {
+	return newBuilder(gojsonschema.TYPE_INTEGER)
+}
+
+// Boolean returns a builder of a boolean schema
+func Boolean() *Builder {
+	return newBuilder(gojsonschema.TYPE_BOOLEAN)
+}
+
+// Null returns a builder of a null schema
+func Null() *Builder {
+	return newBuilder(gojsonschema.TYPE_NULL)
+}
+
+// Ref returns a builder of a schema referencing another, ie Ref("#/definitions/address")
+func Ref(reference string) *Builder {
+	return newBuilder().Keyword(gojsonschema.KEY_REF, reference)
+}
+
+// Keyword sets any keyword, including custom ones
+func (b *Builder) Keyword(name string, value interface{}) *Builder {
+	b.keywords[name] = value
+	return b
+}
+
+// subSchemas returns the builders of a keyword holding named subschemas
+func (b *Builder) subSchemas(keyword string) map[string]*Builder {
+	m, ok := b.keywords[keyword].(map[string]*Builder)
+	if !ok {
+		m = map[string]*Builder{}
+		b.keywords[keyword] = m
+	}
+	return m
+}
+
+// Type sets the types, a single one being written as a string
+func (b *Builder) Type(types ...string) *Builder {
+	if len(types) == 1 {
+		return b.Keyword(gojsonschema.KEY_TYPE, types[0])
+	}
+	return b.Keyword(gojsonschema.KEY_TYPE, types)
+}
+
+// Title sets the title
+func (b *Builder) Title(title string) *Builder {
+	return b.Keyword(gojsonschema.KEY_TITLE, title)
+}
+
+// Description sets the description
+func (b *Builder) Description(description string) *Builder {
+	return b.Keyword(gojsonschema.KEY_DESCRIPTION, description)
+}
+
+// Examples sets the examples
+func (b *Builder) Examples(examples ...interface{}) *Builder {
+	return b.Keyword(gojsonschema.KEY_EXAMPLES, examples)
+}
+
+// ReadOnly sets readOnly
+func (b *Builder) ReadOnly() *Builder {
+	return b.Keyword(gojsonschema.KEY_READ_ONLY, true)
+}
+
+// WriteOnly sets writeOnly
+func (b *Builder) WriteOnly() *Builder {
+	return b.Keyword(gojsonschema.KEY_WRITE_ONLY, true)
+}
+
+// Deprecated sets deprecated
+func (b *Builder) Deprecated() *Builder {
+	return b.Keyword(gojsonschema.KEY_DEPRECATED, true)
+}
+
+// Enum sets the allowed values
+func (b *Builder) Enum(values ...interface{}) *Builder {
+	return b.Keyword(gojsonschema.KEY_ENUM, values)
+}
+
+// Definition adds a schema to definitions, to be referenced by Ref("#/definitions/" + name)
+func (b *Builder) Definition(name string, s *Builder) *Builder {
+	b.subSchemas(gojsonschema.KEY_DEFINITIONS)[name] = s
+	return b
+}
+
+// Prop adds a property
+func (b *Builder) Prop(name string, s *Builder) *Builder {
+	b.subSchemas(gojsonschema.KEY_PROPERTIES)[name] = s
+	return b
+}
+
+// PatternProp adds a schema of the properties matching pattern
+func (b *Builder) PatternProp(pattern string, s *Builder) *Builder {
+	b.subSchemas(gojsonschema.KEY_PATTERN_PROPERTIES)[pattern] = s
+	return b
+}
+
+// AdditionalProperties allows or forbids additional properties
+func (b *Builder) AdditionalProperties(allowed bool) *Builder {
+	return b.Keyword(gojsonschema.KEY_ADDITIONAL_PROPERTIES, allowed)
+}
+
+// AdditionalPropertiesOf sets the schema of additional properties
+func (b *Builder) AdditionalPropertiesOf(s *Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ADDITIONAL_PROPERTIES, s)
+}
+
+// Required adds required properties, the ones already required being kept once
+func (b *Builder) Required(names ...string) *Builder {
+	required, _ := b.keywords[gojsonschema.KEY_REQUIRED].([]string)
+	for _, name := range names {
+		found := false
+		for _, r := range required {
+			if r == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			required = append(required, name)
+		}
+	}
+	return b.Keyword(gojsonschema.KEY_REQUIRED, required)
+}
+
+// MinProperties sets minProperties
+func (b *Builder) MinProperties(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MIN_PROPERTIES, n)
+}
+
+// MaxProperties sets maxProperties
+func (b *Builder) MaxProperties(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MAX_PROPERTIES, n)
+}
+
+// Items sets the schema of all the items
+func (b *Builder) Items(s *Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ITEMS, s)
+}
+
+// TupleItems sets a schema per item position
+func (b *Builder) TupleItems(s ...*Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ITEMS, s)
+}
+
+// AdditionalItems allows or forbids items after the TupleItems
+func (b *Builder) AdditionalItems(allowed bool) *Builder {
+	return b.Keyword(gojsonschema.KEY_ADDITIONAL_ITEMS, allowed)
+}
+
+// MinItems sets minItems
+func (b *Builder) MinItems(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MIN_ITEMS, n)
+}
+
+// MaxItems sets maxItems
+func (b *Builder) MaxItems(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MAX_ITEMS, n)
+}
+
+// UniqueItems sets uniqueItems
+func (b *Builder) UniqueItems() *Builder {
+	return b.Keyword(gojsonschema.KEY_UNIQUE_ITEMS, true)
+}
+
+// MinLength sets minLength
+func (b *Builder) MinLength(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MIN_LENGTH, n)
+}
+
+// MaxLength sets maxLength
+func (b *Builder) MaxLength(n int) *Builder {
+	return b.Keyword(gojsonschema.KEY_MAX_LENGTH, n)
+}
+
+// Pattern sets pattern
+func (b *Builder) Pattern(pattern string) *Builder {
+	return b.Keyword(gojsonschema.KEY_PATTERN, pattern)
+}
+
+// Format sets format
+func (b *Builder) Format(format string) *Builder {
+	return b.Keyword(gojsonschema.KEY_FORMAT, format)
+}
+
+// Minimum sets an inclusive minimum
+func (b *Builder) Minimum(min float64) *Builder {
+	delete(b.keywords, gojsonschema.KEY_EXCLUSIVE_MINIMUM)
+	return b.Keyword(gojsonschema.KEY_MINIMUM, min)
+}
+
+// ExclusiveMinimum sets an exclusive minimum, as minimum and exclusiveMinimum of draft-04
+func (b *Builder) ExclusiveMinimum(min float64) *Builder {
+	return b.Keyword(gojsonschema.KEY_MINIMUM, min).Keyword(gojsonschema.KEY_EXCLUSIVE_MINIMUM, true)
+}
+
+// Maximum sets an inclusive maximum
+func (b *Builder) Maximum(max float64) *Builder {
+	delete(b.keywords, gojsonschema.KEY_EXCLUSIVE_MAXIMUM)
+	return b.Keyword(gojsonschema.KEY_MAXIMUM, max)
+}
+
+// ExclusiveMaximum sets an exclusive maximum, as maximum and exclusiveMaximum of draft-04
+func (b *Builder) ExclusiveMaximum(max float64) *Builder {
+	return b.Keyword(gojsonschema.KEY_MAXIMUM, max).Keyword(gojsonschema.KEY_EXCLUSIVE_MAXIMUM, true)
+}
+
+// MultipleOf sets multipleOf
+func (b *Builder) MultipleOf(n float64) *Builder {
+	return b.Keyword(gojsonschema.KEY_MULTIPLE_OF, n)
+}
+
+// AllOf sets allOf
+func (b *Builder) AllOf(s ...*Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ALL_OF, s)
+}
+
+// AnyOf sets anyOf
+func (b *Builder) AnyOf(s ...*Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ANY_OF, s)
+}
+
+// OneOf sets oneOf
+func (b *Builder) OneOf(s ...*Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_ONE_OF, s)
+}
+
+// Not sets not
+func (b *Builder) Not(s *Builder) *Builder {
+	return b.Keyword(gojsonschema.KEY_NOT, s)
+}
+
+// MarshalJSON returns the schema document, keys being sorted
+func (b *Builder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.keywords)
+}
+
+// Compile compiles the schema document with the default options,
+// use SchemaLoader.Compile(gojsonschema.NewGoLoader(b)) for others
+func (b *Builder) Compile() (*gojsonschema.Schema, error) {
+	return gojsonschema.NewSchema(gojsonschema.NewGoLoader(b))
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema builder.
+//
+// created          18-10-2026
+
+package builder
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func TestBuilder(t *testing.T) {
+
+	b := Object().
+		Prop("name", String().MinLength(1)).
+		Prop("age", Integer().Minimum(0)).
+		Prop("tags", Array().Items(String()).UniqueItems()).
+		Prop("parent", Ref("#")).
+		Required("name").
+		Required("name").
+		AdditionalProperties(false)
+
+	document, err := json.Marshal(b)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 1},
+			"age": {"type": "integer", "minimum": 0},
+			"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true},
+			"parent": {"$ref": "#"}
+		},
+		"required": ["name"],
+		"additionalProperties": false
+	}`, string(document))
+
+	schema, err := b.Compile()
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"name"}, schema.Root().Required())
+
+	result, err := schema.Validate(gojsonschema.NewStringLoader(`{"name": "a", "age": 3, "parent": {"name": "b"}}`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+
+	result, err = schema.Validate(gojsonschema.NewStringLoader(`{"name": "", "parent": {"age": -1}}`))
+	assert.Nil(t, err)
+	assert.Len(t, result.Errors(), 3)
+
+	// invalid keyword values are reported by the parser
+	_, err = Object().Keyword(gojsonschema.KEY_REQUIRED, 1).Compile()
+	assert.NotNil(t, err)
+}
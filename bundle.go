@@ -0,0 +1,324 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Bundling of a schema and the documents it references into a single document.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// Bundler copies the documents referenced by external $ref into the
+// definitions of the root schema, its fields are the bundling options
+type Bundler struct {
+	// DefinitionsKey is the keyword holding the copied documents,
+	// "definitions" when empty ( "$defs" for later drafts )
+	DefinitionsKey string
+}
+
+// bundle is the state of a Bundle call
+type bundle struct {
+	pool           *schemaPool
+	definitionsKey string
+	// taken holds the names used in definitions
+	taken map[string]bool
+	added map[string]interface{}
+	// prefixes holds the JSON Pointer of every bundled document by URL
+	prefixes map[string]string
+	pending  []bundledDocument
+}
+
+// bundledDocument is a document whose references are to be rewritten
+type bundledDocument struct {
+	base     gojsonreference.JsonReference
+	document interface{}
+	// copied is set on the copies of referenced documents
+	copied bool
+}
+
+// Bundle returns the schema document of l, the documents referenced by
+// external $ref being copied into its definitions
+func Bundle(l JSONLoader) (map[string]interface{}, error) {
+	return (&Bundler{}).Bundle(l)
+}
+
+// Bundle returns the schema document of l as a self-contained document :
+// every document an external $ref points to is loaded once, copied whole under
+// the definitions of the root schema, named after its file, and the references
+// are rewritten to the copies. The ids of the copied schemas are removed.
+// The document of l is not modified.
+func (b *Bundler) Bundle(l JSONLoader) (map[string]interface{}, error) {
+
+	key := b.DefinitionsKey
+	if key == "" {
+		key = KEY_DEFINITIONS
+	}
+
+	ref, err := l.JsonReference()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := newSchemaPool(l.LoaderFactory())
+
+	var doc interface{}
+	if ref.String() != "" && ref.String() != "#" {
+		spd, err := pool.GetDocument(ref)
+		if err != nil {
+			return nil, err
+		}
+		doc = spd.Document
+	} else {
+		doc, err = l.LoadJSON()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	root, ok := copyJson(doc).(map[string]interface{})
+	if !ok {
+		return nil, errors.New(formatErrorDescription(
+			Locale.InvalidType(),
+			ErrorDetails{
+				"expected": TYPE_OBJECT,
+				"given":    STRING_SCHEMA,
+			},
+		))
+	}
+
+	definitions := map[string]interface{}{}
+	if v, ok := root[key]; ok {
+		if definitions, ok = v.(map[string]interface{}); !ok {
+			return nil, errors.New(formatErrorDescription(
+				Locale.MustBeOfType(),
+				ErrorDetails{"key": key, "type": TYPE_OBJECT},
+			))
+		}
+	}
+
+	bu := &bundle{
+		pool:           pool,
+		definitionsKey: key,
+		taken:          map[string]bool{},
+		added:          map[string]interface{}{},
+		prefixes:       map[string]string{documentUrl(ref): ""},
+		pending:        []bundledDocument{{base: ref, document: root}},
+	}
+	for _, name := range sortedKeys(definitions) {
+		bu.taken[name] = true
+	}
+
+	for len(bu.pending) > 0 {
+		d := bu.pending[0]
+		bu.pending = bu.pending[1:]
+		if err := walkSchema(d.document, func(schema map[string]interface{}) error {
+			if d.copied {
+				// the references of a copy are rewritten relative to the root,
+				// an id would resolve them against another document
+				delete(schema, KEY_ID)
+				delete(schema, "id") // draft-04 spelling
+			}
+			return bu.rewrite(schema, d.base)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// copies are added last, so that the walk of the root does not see them
+	if len(bu.added) > 0 {
+		for name, document := range bu.added {
+			definitions[name] = document
+		}
+		root[key] = definitions
+	}
+
+	return root, nil
+}
+
+// rewrite points the $ref of schema, found in the document at base, to the bundled copy
+func (bu *bundle) rewrite(schema map[string]interface{}, base gojsonreference.JsonReference) error {
+
+	reference, ok := schema[KEY_REF].(string)
+	if !ok {
+		return nil
+	}
+
+	jsonReference, err := gojsonreference.NewJsonReference(reference)
+	if err != nil {
+		return err
+	}
+	if !jsonReference.HasFullUrl {
+		if jsonReference, err = inheritReference(base, jsonReference); err != nil {
+			return err
+		}
+	}
+
+	url := documentUrl(jsonReference)
+	prefix, ok := bu.prefixes[url]
+	if !ok {
+		spd, err := bu.pool.GetDocument(jsonReference)
+		if err != nil {
+			return err
+		}
+		name := bu.name(jsonReference)
+		prefix = "/" + bu.definitionsKey + "/" + name
+		bu.prefixes[url] = prefix
+
+		document := copyJson(spd.Document)
+		bu.added[name] = document
+		bu.pending = append(bu.pending, bundledDocument{base: jsonReference, document: document, copied: true})
+	}
+
+	// the fragment stays escaped, ie %25 in #/percent%25field
+	schema[KEY_REF] = "#" + prefix + jsonReference.GetUrl().EscapedFragment()
+
+	return nil
+}
+
+// name returns a free definition name for a document, after its file name
+func (bu *bundle) name(ref gojsonreference.JsonReference) string {
+
+	u := ref.GetUrl()
+	name := strings.TrimSuffix(path.Base(u.Path), path.Ext(u.Path))
+	if name == "" || name == "." || name == "/" {
+		name = u.Host
+	}
+	name = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == '.' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+		return '_'
+	}, name)
+	if name == "" {
+		name = STRING_SCHEMA
+	}
+
+	free := name
+	for i := 2; bu.taken[free]; i++ {
+		free = name + "_" + strconv.Itoa(i)
+	}
+	bu.taken[free] = true
+
+	return free
+}
+
+// inheritReference resolves a relative reference against the reference of its document
+func inheritReference(base gojsonreference.JsonReference, ref gojsonreference.JsonReference) (gojsonreference.JsonReference, error) {
+	inherited, err := base.Inherits(ref)
+	if err != nil {
+		return ref, err
+	}
+	return *inherited, nil
+}
+
+// documentUrl returns the URL of the document a reference points into
+func documentUrl(ref gojsonreference.JsonReference) string {
+	if ref.GetUrl() == nil {
+		return ""
+	}
+	u := *ref.GetUrl()
+	u.Fragment = ""
+	return u.String()
+}
+
+// walkSchema calls f on every schema object of document, at the locations
+// draft-04 defines as schemas and at the other locations a $ref can point to,
+// as "#/integer". The values of enum, default and examples are instances.
+func walkSchema(document interface{}, f func(schema map[string]interface{}) error) error {
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := f(m); err != nil {
+		return err
+	}
+
+	for _, k := range sortedKeys(m) {
+		v := m[k]
+		switch k {
+		case KEY_PROPERTIES, KEY_PATTERN_PROPERTIES, KEY_DEFINITIONS, KEY_DEPENDENCIES:
+			// property dependencies are arrays, walkSchema skips them
+			if children, ok := v.(map[string]interface{}); ok {
+				for _, name := range sortedKeys(children) {
+					if err := walkSchema(children[name], f); err != nil {
+						return err
+					}
+				}
+			}
+		case KEY_ITEMS, KEY_ALL_OF, KEY_ANY_OF, KEY_ONE_OF:
+			if a, ok := v.([]interface{}); ok {
+				for _, item := range a {
+					if err := walkSchema(item, f); err != nil {
+						return err
+					}
+				}
+			} else if err := walkSchema(v, f); err != nil {
+				return err
+			}
+		case KEY_ADDITIONAL_ITEMS, KEY_ADDITIONAL_PROPERTIES, KEY_NOT:
+			if err := walkSchema(v, f); err != nil {
+				return err
+			}
+		case KEY_ENUM, "default", KEY_EXAMPLES:
+		default:
+			if a, ok := v.([]interface{}); ok {
+				for _, item := range a {
+					if err := walkSchema(item, f); err != nil {
+						return err
+					}
+				}
+			} else if err := walkSchema(v, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyJson returns a deep copy of a decoded JSON value
+func copyJson(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = copyJson(e)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, e := range v {
+			a[i] = copyJson(e)
+		}
+		return a
+	}
+	return value
+}
@@ -0,0 +1,243 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the bundling of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundle(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"root.json": `{
+			"properties": {
+				"address": {"$ref": "address.json"},
+				"city": {"$ref": "address.json#/definitions/city"},
+				"other": {"$ref": "other/address.json"},
+				"self": {"$ref": "#/definitions/address"}
+			},
+			"definitions": {"address": {"type": "string"}}
+		}`,
+		"address.json": `{
+			"properties": {"city": {"$ref": "#/definitions/city"}, "root": {"$ref": "root.json"}},
+			"required": ["city"],
+			"definitions": {"city": {"type": "string", "minLength": 1}}
+		}`,
+		"other/address.json": `{"type": "object", "properties": {"zip": {"enum": [{"$ref": "not a schema"}]}}}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0755)
+		ioutil.WriteFile(path, []byte(content), 0644)
+	}
+
+	loader := NewReferenceLoader("file://" + filepath.ToSlash(filepath.Join(dir, "root.json")))
+	bundled, err := Bundle(loader)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document, err := json.Marshal(bundled)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"properties": {
+			"address": {"$ref": "#/definitions/address_2"},
+			"city": {"$ref": "#/definitions/address_2/definitions/city"},
+			"other": {"$ref": "#/definitions/address_3"},
+			"self": {"$ref": "#/definitions/address"}
+		},
+		"definitions": {
+			"address": {"type": "string"},
+			"address_2": {
+				"properties": {"city": {"$ref": "#/definitions/address_2/definitions/city"}, "root": {"$ref": "#"}},
+				"required": ["city"],
+				"definitions": {"city": {"type": "string", "minLength": 1}}
+			},
+			"address_3": {"type": "object", "properties": {"zip": {"enum": [{"$ref": "not a schema"}]}}}
+		}
+	}`, string(document))
+
+	// the bundle validates as the original schema
+	original, err := NewSchema(loader)
+	assert.Nil(t, err)
+	schema, err := NewSchema(NewGoLoader(bundled))
+	if !assert.Nil(t, err) {
+		return
+	}
+	for _, instance := range []string{`{"address": {"city": "Paris"}}`, `{"address": {"city": ""}}`, `{"city": "", "other": 1}`} {
+		expected, err := original.Validate(NewStringLoader(instance))
+		assert.Nil(t, err)
+		result, err := schema.Validate(NewStringLoader(instance))
+		assert.Nil(t, err)
+		assert.Equal(t, expected.Valid(), result.Valid(), instance)
+	}
+
+	bundled, err = (&Bundler{DefinitionsKey: "$defs"}).Bundle(NewStringLoader(`{"$ref": "file://` + filepath.ToSlash(filepath.Join(dir, "other", "address.json")) + `"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "#/$defs/address", bundled[KEY_REF])
+
+	_, err = Bundle(NewStringLoader(`{"$ref": "missing.json"}`))
+	assert.NotNil(t, err)
+}
+
+func TestBundleRemovesIds(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	base := "file://" + filepath.ToSlash(dir)
+	files := map[string]string{
+		"root.json": `{"id": "` + base + `/root.json", "properties": {"line": {"$ref": "line.json"}}}`,
+		"line.json": `{
+			"$id": "` + base + `/line.json",
+			"properties": {"amount": {"$ref": "#/definitions/amount"}},
+			"definitions": {"amount": {"id": "#amount", "type": "number"}}
+		}`,
+	}
+	for name, content := range files {
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+
+	bundled, err := Bundle(NewReferenceLoader(base + "/root.json"))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document, err := json.Marshal(bundled)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"id": "`+base+`/root.json",
+		"properties": {"line": {"$ref": "#/definitions/line"}},
+		"definitions": {
+			"line": {
+				"properties": {"amount": {"$ref": "#/definitions/line/definitions/amount"}},
+				"definitions": {"amount": {"type": "number"}}
+			}
+		}
+	}`, string(document))
+
+	// the references of the copy resolve in the bundle
+	schema, err := NewSchema(NewGoLoader(bundled))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err := schema.Validate(NewStringLoader(`{"line": {"amount": "1"}}`))
+	assert.Nil(t, err)
+	assert.False(t, result.Valid())
+}
+
+func TestBundleSchemasOutsideKeywords(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// refRemote/schema_2.json, the document being loaded from a file
+	subSchemas, err := ioutil.ReadFile(filepath.Join("json_schema_test_suite", "refRemote", "remoteFiles", "subSchemas.json"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	ioutil.WriteFile(filepath.Join(dir, "subSchemas.json"), subSchemas, 0644)
+	ioutil.WriteFile(filepath.Join(dir, "root.json"), []byte(`{"$ref": "subSchemas.json#/refToInteger", "$defs": {"a": {"$ref": "subSchemas.json#/integer"}}}`), 0644)
+
+	bundled, err := Bundle(NewReferenceLoader("file://" + filepath.ToSlash(filepath.Join(dir, "root.json"))))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document, err := json.Marshal(bundled)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"$ref": "#/definitions/subSchemas/refToInteger",
+		"$defs": {"a": {"$ref": "#/definitions/subSchemas/integer"}},
+		"definitions": {
+			"subSchemas": {
+				"integer": {"type": "integer"},
+				"refToInteger": {"$ref": "#/definitions/subSchemas/integer"}
+			}
+		}
+	}`, string(document))
+
+	schema, err := NewSchema(NewGoLoader(bundled))
+	if !assert.Nil(t, err) {
+		return
+	}
+	for instance, valid := range map[string]bool{`1`: true, `"a"`: false} {
+		result, err := schema.Validate(NewStringLoader(instance))
+		if assert.Nil(t, err) {
+			assert.Equal(t, valid, result.Valid(), instance)
+		}
+	}
+}
+
+func TestBundleTestSuite(t *testing.T) {
+
+	forEachTestSuiteSchema(t, func(path string, original *Schema) {
+		abs, err := filepath.Abs(path)
+		if !assert.Nil(t, err) {
+			return
+		}
+		bundled, err := Bundle(NewReferenceLoader("file://" + filepath.ToSlash(abs)))
+		if !assert.Nil(t, err, path) {
+			return
+		}
+		sl := NewSchemaLoader()
+		sl.UnknownFormats = UNKNOWN_FORMAT_IGNORE
+		schema, err := sl.Compile(NewGoLoader(bundled))
+		if !assert.Nil(t, err, path) {
+			return
+		}
+
+		names, instances := testSuiteInstances(path)
+		for i, instance := range instances {
+			expected, err := original.Validate(instance)
+			if !assert.Nil(t, err, names[i]) {
+				continue
+			}
+			result, err := schema.Validate(instance)
+			if assert.Nil(t, err, names[i]) {
+				assert.Equal(t, expected.Valid(), result.Valid(), names[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command gojsonschema-gen generates Go types from a JSON schema.
+//
+// created          18-10-2026
+
+// Command gojsonschema-gen generates Go types from a JSON schema.
+//
+// Usage:
+//
+//	gojsonschema-gen [-package name] [-type name] [-o file] schema.json
+//
+// The schema can be a local path or a URL (file://, http://...).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/xeipuuv/gojsonschema/cmd/internal/reference"
+)
+
+func main() {
+
+	packageName := flag.String("package", "main", "package name of the generated file")
+	typeName := flag.String("type", "Root", "name of the type generated for the root schema")
+	output := flag.String("o", "", "output file (default: standard output)")
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: gojsonschema-gen [flags] schema\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader(reference.FromPath(flag.Arg(0))))
+	if err != nil {
+		fail(err)
+	}
+
+	source, err := gojsonschema.NewGoGenerator(*packageName, *typeName).Generate(schema)
+	if err != nil {
+		fail(err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(source)
+		return
+	}
+
+	if err := ioutil.WriteFile(*output, source, 0644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "gojsonschema-gen: %s\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command bundle: a schema and its external references as a single document.
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/xeipuuv/gojsonschema/cmd/internal/reference"
+)
+
+func runBundle(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("gojsonschema bundle", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var refDirs refDirFlag
+	definitions := flags.String("definitions", "definitions", "keyword holding the bundled documents, ie $defs")
+	flags.Var(&refDirs, "ref-dir", "serve remote references from local files, as `uri-prefix=dir` or dir ( repeatable )")
+
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: gojsonschema bundle [flags] schema\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return exitError
+	}
+
+	bundler := gojsonschema.Bundler{DefinitionsKey: *definitions}
+	bundled, err := bundler.Bundle(newRefDirLoader(gojsonschema.NewReferenceLoader(reference.FromPath(flags.Arg(0))), refDirs))
+	if err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s: %s\n", flags.Arg(0), err)
+		return exitError
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(bundled); err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s\n", err)
+		return exitError
+	}
+
+	return exitValid
+}
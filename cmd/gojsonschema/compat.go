@@ -0,0 +1,95 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command compat: compatibility of a new version of a schema with the old one.
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/xeipuuv/gojsonschema/cmd/internal/reference"
+)
+
+// compatModes tell whether a Compatibility satisfies a mode
+var compatModes = map[string]func(c *gojsonschema.Compatibility) bool{
+	"backward": (*gojsonschema.Compatibility).Backward,
+	"forward":  (*gojsonschema.Compatibility).Forward,
+	"full":     (*gojsonschema.Compatibility).Full,
+}
+
+func runCompat(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("gojsonschema compat", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var refDirs refDirFlag
+	mode := flags.String("mode", "backward", "compatibility required: backward, forward or full")
+	flags.Var(&refDirs, "ref-dir", "serve remote references from local files, as `uri-prefix=dir` or dir ( repeatable )")
+
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: gojsonschema compat [flags] old-schema new-schema\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return exitError
+	}
+
+	compatible, ok := compatModes[*mode]
+	if !ok {
+		fmt.Fprintf(stderr, "gojsonschema: unknown mode %q\n", *mode)
+		return exitError
+	}
+
+	schemas := make([]*gojsonschema.Schema, 2)
+	for i, path := range flags.Args() {
+		schema, err := gojsonschema.NewSchemaLoader().Compile(newRefDirLoader(gojsonschema.NewReferenceLoader(reference.FromPath(path)), refDirs))
+		if err != nil {
+			fmt.Fprintf(stderr, "gojsonschema: %s: %s\n", path, err)
+			return exitError
+		}
+		schemas[i] = schema
+	}
+
+	compatibility := gojsonschema.CheckCompatibility(schemas[0], schemas[1])
+	for _, change := range compatibility.Changes() {
+		fmt.Fprintf(stdout, "%s\n", change)
+	}
+
+	if !compatible(compatibility) {
+		fmt.Fprintf(stdout, "%s is not %s compatible with %s\n", flags.Arg(1), *mode, flags.Arg(0))
+		return exitInvalid
+	}
+
+	fmt.Fprintf(stdout, "%s is %s compatible with %s\n", flags.Arg(1), *mode, flags.Arg(0))
+	return exitValid
+}
@@ -0,0 +1,94 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command infer: a draft schema inferred from sample documents.
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func runInfer(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	flags := flag.NewFlagSet("gojsonschema infer", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	requiredRatio := flags.Float64("required-ratio", 1, "share of the objects a property must appear in to be required, in ]0, 1]")
+	enumMax := flags.Int("enum-max", 10, "number of distinct strings above which no enum is inferred, negative for none")
+	ndjson := flags.Bool("ndjson", false, "read every line of the inputs as a separate document")
+
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: gojsonschema infer [flags] [document...]\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if *requiredRatio <= 0 || *requiredRatio > 1 {
+		fmt.Fprintf(stderr, "gojsonschema: -required-ratio must be in ]0, 1]\n")
+		return exitError
+	}
+
+	inputs := flags.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+
+	documents, err := readDocuments(inputs, stdin, *ndjson)
+	if err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s\n", err)
+		return exitError
+	}
+
+	inferrer := gojsonschema.NewSchemaInferrer()
+	inferrer.RequiredRatio = *requiredRatio
+	inferrer.MaxEnumValues = *enumMax
+	if *enumMax == 0 {
+		inferrer.MaxEnumValues = -1
+	}
+
+	for _, d := range documents {
+		if err := inferrer.Add(gojsonschema.NewStringLoader(d.source)); err != nil {
+			fmt.Fprintf(stderr, "gojsonschema: %s: %s\n", d.name, err)
+			return exitError
+		}
+	}
+
+	encoder := json.NewEncoder(stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(inferrer.Schema()); err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s\n", err)
+		return exitError
+	}
+
+	return exitValid
+}
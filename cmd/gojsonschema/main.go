@@ -0,0 +1,226 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Command gojsonschema validates JSON documents against a schema.
+//
+// created          18-10-2026
+
+// Command gojsonschema validates JSON documents against a schema.
+//
+// Usage:
+//
+//	gojsonschema [flags] schema [document...]
+//	gojsonschema bundle [flags] schema
+//	gojsonschema compat [flags] old-schema new-schema
+//	gojsonschema infer [flags] [document...]
+//
+// Documents are files, glob patterns or - for the standard input ( the
+// default when no document is given ). With -ndjson every line of every
+// input is validated as a separate document.
+//
+// The exit status is 0 when all documents are valid, 1 when at least one
+// document is invalid and 2 when the schema or a document cannot be loaded.
+//
+// The bundle command writes the schema with the documents its external
+// references point to copied into its definitions, as a single document.
+//
+// The compat command lists the changes between two versions of a schema, its
+// exit status is 1 when the new version is not compatible with the old one.
+//
+// The infer command writes a schema the given documents are valid against,
+// read like the documents to validate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/xeipuuv/gojsonschema/cmd/internal/reference"
+)
+
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+// commands are run by name, without a command the documents are validated
+var commands = map[string]func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int{
+	"bundle": runBundle,
+	"compat": runCompat,
+	"infer":  runInfer,
+}
+
+// document is a JSON document to validate, named after its origin
+type document struct {
+	name   string
+	source string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+
+	// a file named after a command is a schema, as is any name following --
+	if len(args) > 0 {
+		if command, ok := commands[args[0]]; ok && !isFile(args[0]) {
+			return command(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	flags := flag.NewFlagSet("gojsonschema", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	var refDirs refDirFlag
+	format := flags.String("format", "text", "output format: text, json or junit")
+	ndjson := flags.Bool("ndjson", false, "validate every line of the inputs as a separate document")
+	strict := flags.Bool("strict", false, "reject schemas using keywords unknown to draft-04")
+	flags.Var(&refDirs, "ref-dir", "serve remote references from local files, as `uri-prefix=dir` or dir ( repeatable )")
+
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: gojsonschema [flags] schema [document...]\n       gojsonschema bundle [flags] schema\n       gojsonschema compat [flags] old-schema new-schema\n       gojsonschema infer [flags] [document...]\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		return exitError
+	}
+
+	report, ok := reporters[*format]
+	if !ok {
+		fmt.Fprintf(stderr, "gojsonschema: unknown format %q\n", *format)
+		return exitError
+	}
+
+	schemaLoader := newRefDirLoader(gojsonschema.NewReferenceLoader(reference.FromPath(flags.Arg(0))), refDirs)
+	sl := gojsonschema.NewSchemaLoader()
+	sl.Strict = *strict
+	schema, err := sl.Compile(schemaLoader)
+	if err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s: %s\n", flags.Arg(0), err)
+		return exitError
+	}
+
+	inputs := flags.Args()[1:]
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+
+	documents, err := readDocuments(inputs, stdin, *ndjson)
+	if err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s\n", err)
+		return exitError
+	}
+
+	status := exitValid
+	results := make([]documentResult, 0, len(documents))
+
+	for _, d := range documents {
+		result, err := schema.Validate(gojsonschema.NewStringLoader(d.source))
+		if err != nil {
+			status = exitError
+		} else if !result.Valid() && status == exitValid {
+			status = exitInvalid
+		}
+		results = append(results, documentResult{document: d, result: result, err: err})
+	}
+
+	if err := report(stdout, results); err != nil {
+		fmt.Fprintf(stderr, "gojsonschema: %s\n", err)
+		return exitError
+	}
+
+	return status
+}
+
+// readDocuments expands the globs and reads every input, "-" being the standard input
+func readDocuments(inputs []string, stdin io.Reader, ndjson bool) ([]document, error) {
+
+	var documents []document
+
+	for _, input := range inputs {
+
+		names := []string{input}
+		if input != "-" {
+			matches, err := filepath.Glob(input)
+			if err != nil {
+				return nil, err
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("%s: no such file", input)
+			}
+			names = matches
+		}
+
+		for _, name := range names {
+			var content []byte
+			var err error
+			if name == "-" {
+				name = "<stdin>"
+				content, err = ioutil.ReadAll(stdin)
+			} else {
+				content, err = ioutil.ReadFile(name)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !ndjson {
+				documents = append(documents, document{name: name, source: string(content)})
+				continue
+			}
+
+			scanner := bufio.NewScanner(bytes.NewReader(content))
+			scanner.Buffer(make([]byte, 64*1024), len(content)+1)
+			for line := 1; scanner.Scan(); line++ {
+				if strings.TrimSpace(scanner.Text()) == "" {
+					continue
+				}
+				documents = append(documents, document{name: fmt.Sprintf("%s:%d", name, line), source: scanner.Text()})
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return documents, nil
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,198 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the gojsonschema command.
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"schema.json":                     `{"type": "object", "properties": {"a": {"$ref": "http://example.com/integer.json"}}, "required": ["a"]}`,
+		"mirror/example.com/integer.json": `{"type": "integer"}`,
+		"valid.json":                      `{"a": 1}`,
+		"invalid.json":                    `{"a": "one"}`,
+		"lines.ndjson":                    "{\"a\": 1}\n\n{}\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0755)
+		ioutil.WriteFile(path, []byte(content), 0644)
+	}
+
+	schema := filepath.Join(dir, "schema.json")
+	refDir := "-ref-dir=" + filepath.Join(dir, "mirror")
+
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{refDir, schema, filepath.Join(dir, "valid.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitValid, status, stderr.String())
+
+	stdout.Reset()
+	status = run([]string{refDir, schema, filepath.Join(dir, "*valid.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitInvalid, status)
+	assert.Contains(t, stdout.String(), "invalid.json: invalid")
+
+	stdout.Reset()
+	status = run([]string{refDir, "-ndjson", "-format=json", schema, filepath.Join(dir, "lines.ndjson")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitInvalid, status)
+	assert.Contains(t, stdout.String(), "lines.ndjson:3")
+	assert.Contains(t, stdout.String(), `"type": "required"`)
+
+	stdout.Reset()
+	status = run([]string{refDir, "-format=junit", schema}, strings.NewReader(`{"a": 2}`), &stdout, &stderr)
+	assert.Equal(t, exitValid, status)
+	assert.Contains(t, stdout.String(), `<testsuite name="gojsonschema" tests="1" failures="0" errors="0">`)
+
+	status = run([]string{refDir, schema}, strings.NewReader(`{"a": `), &stdout, &stderr)
+	assert.Equal(t, exitError, status)
+}
+
+func TestRunBundle(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	os.MkdirAll(filepath.Join(dir, "mirror", "example.com"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "schema.json"), []byte(`{"properties": {"a": {"$ref": "http://example.com/integer.json"}}}`), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "mirror", "example.com", "integer.json"), []byte(`{"type": "integer"}`), 0644)
+
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{"bundle", "-ref-dir=" + filepath.Join(dir, "mirror"), filepath.Join(dir, "schema.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitValid, status, stderr.String())
+	assert.JSONEq(t, `{
+		"properties": {"a": {"$ref": "#/definitions/integer"}},
+		"definitions": {"integer": {"type": "integer"}}
+	}`, stdout.String())
+
+	status = run([]string{"bundle", filepath.Join(dir, "schema.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitError, status)
+}
+
+func TestRunCompat(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "v1.json"), []byte(`{"properties": {"name": {"type": "string"}}}`), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "v2.json"), []byte(`{"properties": {"name": {"type": "string"}}, "required": ["name"]}`), 0644)
+
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{"compat", filepath.Join(dir, "v1.json"), filepath.Join(dir, "v2.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitInvalid, status, stderr.String())
+	assert.Contains(t, stdout.String(), "/required: required added name ( breaks backward compatibility )")
+
+	stdout.Reset()
+	status = run([]string{"compat", "-mode=forward", filepath.Join(dir, "v1.json"), filepath.Join(dir, "v2.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitValid, status, stderr.String())
+
+	status = run([]string{"compat", "-mode=sideways", filepath.Join(dir, "v1.json"), filepath.Join(dir, "v2.json")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitError, status)
+}
+
+func TestRunInfer(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "samples.ndjson"), []byte("{\"a\": 1, \"b\": \"x\"}\n{\"a\": 2}\n"), 0644)
+
+	var stdout, stderr bytes.Buffer
+
+	status := run([]string{"infer", "-ndjson", "-required-ratio=0.5", filepath.Join(dir, "samples.ndjson")}, nil, &stdout, &stderr)
+	assert.Equal(t, exitValid, status, stderr.String())
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"type": "object",
+		"properties": {"a": {"type": "integer"}, "b": {"type": "string"}},
+		"required": ["a", "b"]
+	}`, stdout.String())
+
+	stdout.Reset()
+	status = run([]string{"infer"}, strings.NewReader(`[1, 2.5]`), &stdout, &stderr)
+	assert.Equal(t, exitValid, status, stderr.String())
+	assert.JSONEq(t, `{"$schema": "http://json-schema.org/draft-04/schema#", "type": "array", "items": {"type": "number"}}`, stdout.String())
+
+	status = run([]string{"infer", "-required-ratio=2"}, nil, &stdout, &stderr)
+	assert.Equal(t, exitError, status)
+}
+
+func TestRunSchemaNamedAfterCommand(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.Chdir(wd)
+	os.Chdir(dir)
+
+	ioutil.WriteFile("document.json", []byte(`1`), 0644)
+
+	var stdout, stderr bytes.Buffer
+
+	// no such file, the command runs
+	status := run([]string{"bundle", "document.json", "document.json"}, nil, &stdout, &stderr)
+	assert.Equal(t, exitError, status)
+
+	ioutil.WriteFile("bundle", []byte(`{"type": "string"}`), 0644)
+
+	status = run([]string{"bundle", "document.json"}, nil, &stdout, &stderr)
+	assert.Equal(t, exitInvalid, status, stderr.String())
+
+	status = run([]string{"--", "bundle", "document.json"}, nil, &stdout, &stderr)
+	assert.Equal(t, exitInvalid, status, stderr.String())
+}
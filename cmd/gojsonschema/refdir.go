@@ -0,0 +1,114 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Maps remote references to local files ( -ref-dir ).
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/xeipuuv/gojsonschema/cmd/internal/reference"
+)
+
+// refDir maps the references starting with prefix to the files of dir.
+// Without prefix, http(s) references are mapped to dir/host/path.
+type refDir struct {
+	prefix string
+	dir    string
+}
+
+// refDirFlag collects the repeatable -ref-dir flag
+type refDirFlag []refDir
+
+func (f *refDirFlag) String() string {
+	s := make([]string, len(*f))
+	for i, d := range *f {
+		s[i] = d.prefix + "=" + d.dir
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *refDirFlag) Set(value string) error {
+	if i := strings.Index(value, "="); i > 0 {
+		*f = append(*f, refDir{prefix: value[:i], dir: value[i+1:]})
+	} else {
+		*f = append(*f, refDir{dir: value})
+	}
+	return nil
+}
+
+// path returns the local file a reference maps to
+func (d refDir) path(reference string) (string, bool) {
+
+	if d.prefix != "" {
+		if !strings.HasPrefix(reference, d.prefix) {
+			return "", false
+		}
+		return filepath.Join(d.dir, filepath.FromSlash(strings.TrimPrefix(reference, d.prefix))), true
+	}
+
+	u, err := url.Parse(reference)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", false
+	}
+
+	return filepath.Join(d.dir, u.Host, filepath.FromSlash(u.Path)), true
+}
+
+// refDirLoaderFactory loads references from the local files they map to,
+// falling back to the default factory ( file or HTTP )
+type refDirLoaderFactory struct {
+	dirs refDirFlag
+}
+
+func (f refDirLoaderFactory) New(source string) gojsonschema.JSONLoader {
+
+	for _, d := range f.dirs {
+		if path, ok := d.path(source); ok {
+			if _, err := os.Stat(path); err == nil {
+				return gojsonschema.NewReferenceLoader(reference.FromPath(path))
+			}
+		}
+	}
+
+	return gojsonschema.DefaultJSONLoaderFactory{}.New(source)
+}
+
+// refDirLoader is a JSONLoader whose references are resolved through a refDirLoaderFactory
+type refDirLoader struct {
+	gojsonschema.JSONLoader
+	factory refDirLoaderFactory
+}
+
+func newRefDirLoader(l gojsonschema.JSONLoader, dirs refDirFlag) *refDirLoader {
+	return &refDirLoader{JSONLoader: l, factory: refDirLoaderFactory{dirs: dirs}}
+}
+
+func (l *refDirLoader) LoaderFactory() gojsonschema.JSONLoaderFactory {
+	return l.factory
+}
@@ -0,0 +1,174 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Output formats of the validation results: text, json and junit.
+//
+// created          18-10-2026
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// documentResult is the outcome of the validation of a document,
+// err is set when the document could not be loaded
+type documentResult struct {
+	document document
+	result   *gojsonschema.Result
+	err      error
+}
+
+type reporter func(w io.Writer, results []documentResult) error
+
+var reporters = map[string]reporter{
+	"text":  reportText,
+	"json":  reportJSON,
+	"junit": reportJUnit,
+}
+
+func reportText(w io.Writer, results []documentResult) error {
+
+	for _, r := range results {
+		switch {
+		case r.err != nil:
+			fmt.Fprintf(w, "%s: error: %s\n", r.document.name, r.err)
+		case r.result.Valid():
+			fmt.Fprintf(w, "%s: valid\n", r.document.name)
+		default:
+			fmt.Fprintf(w, "%s: invalid\n", r.document.name)
+			for _, e := range r.result.Errors() {
+				fmt.Fprintf(w, "  - %s\n", e)
+			}
+		}
+	}
+
+	return nil
+}
+
+type jsonError struct {
+	Type        string      `json:"type"`
+	Field       string      `json:"field"`
+	Context     string      `json:"context"`
+	Description string      `json:"description"`
+	Value       interface{} `json:"value"`
+}
+
+type jsonResult struct {
+	Document string      `json:"document"`
+	Valid    bool        `json:"valid"`
+	Error    string      `json:"error,omitempty"`
+	Errors   []jsonError `json:"errors,omitempty"`
+}
+
+func reportJSON(w io.Writer, results []documentResult) error {
+
+	out := make([]jsonResult, 0, len(results))
+
+	for _, r := range results {
+		jr := jsonResult{Document: r.document.name}
+		if r.err != nil {
+			jr.Error = r.err.Error()
+		} else {
+			jr.Valid = r.result.Valid()
+			for _, e := range r.result.Errors() {
+				jr.Errors = append(jr.Errors, jsonError{
+					Type:        e.Type(),
+					Field:       e.Field(),
+					Context:     e.Context().String(),
+					Description: e.Description(),
+					Value:       e.Value(),
+				})
+			}
+		}
+		out = append(out, jr)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+func reportJUnit(w io.Writer, results []documentResult) error {
+
+	suite := junitTestSuite{Name: "gojsonschema", Tests: len(results)}
+
+	for _, r := range results {
+		tc := junitTestCase{Name: r.document.name, ClassName: "gojsonschema"}
+		switch {
+		case r.err != nil:
+			suite.Errors++
+			tc.Error = &junitMessage{Message: r.err.Error()}
+		case !r.result.Valid():
+			suite.Failures++
+			lines := make([]string, 0, len(r.result.Errors()))
+			for _, e := range r.result.Errors() {
+				lines = append(lines, fmt.Sprint(e))
+			}
+			tc.Failure = &junitMessage{
+				Message: fmt.Sprintf("%d validation error(s)", len(lines)),
+				Text:    strings.Join(lines, "\n"),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suite); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
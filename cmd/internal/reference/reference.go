@@ -0,0 +1,46 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      References of the files given on the command line.
+//
+// created          18-10-2026
+
+// Package reference turns the paths given to the commands into references.
+package reference
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// FromPath turns a path into a file:// reference, URLs are kept as is
+func FromPath(path string) string {
+
+	if strings.Contains(path, "://") {
+		return path
+	}
+
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	return "file://" + filepath.ToSlash(path)
+}
@@ -0,0 +1,667 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Compatibility of two versions of a schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CHANGE_PROPERTY_ADDED is a property the new schema defines
+	CHANGE_PROPERTY_ADDED = "property_added"
+	// CHANGE_PROPERTY_REMOVED is a property the new schema no longer defines
+	CHANGE_PROPERTY_REMOVED = "property_removed"
+	// CHANGE_REQUIRED_ADDED is a property the new schema requires
+	CHANGE_REQUIRED_ADDED = "required_added"
+	// CHANGE_REQUIRED_REMOVED is a property the new schema no longer requires
+	CHANGE_REQUIRED_REMOVED = "required_removed"
+	// CHANGE_NARROWED is a keyword of the new schema accepting less values
+	CHANGE_NARROWED = "narrowed"
+	// CHANGE_WIDENED is a keyword of the new schema accepting more values
+	CHANGE_WIDENED = "widened"
+	// CHANGE_CHANGED is a keyword of the new schema accepting other values
+	CHANGE_CHANGED = "changed"
+)
+
+// CompatibilityChange is a difference between two versions of a schema
+type CompatibilityChange struct {
+	// Location is the JSON Pointer of the keyword or property in the schema,
+	// $ref being followed
+	Location string
+	Keyword  string
+	Kind     string
+	Old      interface{}
+	New      interface{}
+
+	// BreaksBackward is set when data valid against the old schema may be
+	// invalid against the new one
+	BreaksBackward bool
+	// BreaksForward is set when data valid against the new schema may be
+	// invalid against the old one
+	BreaksForward bool
+}
+
+func (c CompatibilityChange) String() string {
+
+	var breaks []string
+	if c.BreaksBackward {
+		breaks = append(breaks, "backward")
+	}
+	if c.BreaksForward {
+		breaks = append(breaks, "forward")
+	}
+
+	s := fmt.Sprintf("%s: %s %s", pointerOrRoot(c.Location), c.Keyword, c.Kind)
+	switch c.Kind {
+	case CHANGE_PROPERTY_ADDED, CHANGE_PROPERTY_REMOVED, CHANGE_REQUIRED_ADDED, CHANGE_REQUIRED_REMOVED:
+		// the kind names the keyword
+		s = fmt.Sprintf("%s: %s", pointerOrRoot(c.Location), strings.Replace(c.Kind, "_", " ", -1))
+	}
+	switch {
+	case c.Kind == CHANGE_PROPERTY_ADDED || c.Kind == CHANGE_PROPERTY_REMOVED:
+		// the location names the property
+	case c.Old != nil && c.New != nil:
+		s += fmt.Sprintf(" from %v to %v", c.Old, c.New)
+	case c.Old != nil:
+		s += fmt.Sprintf(" %v", c.Old)
+	case c.New != nil:
+		s += fmt.Sprintf(" %v", c.New)
+	}
+	if len(breaks) > 0 {
+		s += " ( breaks " + strings.Join(breaks, " and ") + " compatibility )"
+	}
+
+	return s
+}
+
+// Compatibility holds the changes between two versions of a schema
+type Compatibility struct {
+	changes []CompatibilityChange
+}
+
+// Changes returns every change found, compatible ones included
+func (c *Compatibility) Changes() []CompatibilityChange {
+	return c.changes
+}
+
+// Backward reports whether data valid against the old schema is valid against
+// the new one, ie old producers can still send data to new consumers
+func (c *Compatibility) Backward() bool {
+	for _, change := range c.changes {
+		if change.BreaksBackward {
+			return false
+		}
+	}
+	return true
+}
+
+// Forward reports whether data valid against the new schema is valid against
+// the old one, ie old consumers accept the data of new producers
+func (c *Compatibility) Forward() bool {
+	for _, change := range c.changes {
+		if change.BreaksForward {
+			return false
+		}
+	}
+	return true
+}
+
+// Full reports both backward and forward compatibility
+func (c *Compatibility) Full() bool {
+	return c.Backward() && c.Forward()
+}
+
+// CheckCompatibility compares two versions of a schema, keyword by keyword.
+//
+// The analysis is conservative : a change is reported as breaking when some
+// data may be accepted by one version and not the other. Adding or removing a
+// property breaks compatibility on the side where additional properties are
+// allowed, since the property may already be used with other values.
+// Compositions are compared position by position.
+func CheckCompatibility(oldSchema *Schema, newSchema *Schema) *Compatibility {
+	c := &compatibilityChecker{visited: map[compatibilityPair]bool{}}
+	c.compare(oldSchema.rootSchema, newSchema.rootSchema, "", false)
+	return &Compatibility{changes: c.changes}
+}
+
+type compatibilityPair struct {
+	old, updated *subSchema
+	inverted     bool
+}
+
+type compatibilityChecker struct {
+	changes []CompatibilityChange
+	visited map[compatibilityPair]bool
+}
+
+// add records a change, inverted being set under not, where accepting less
+// values means accepting more
+func (c *compatibilityChecker) add(location string, keyword string, kind string, old interface{}, updated interface{}, inverted bool) {
+
+	change := CompatibilityChange{Location: location, Keyword: keyword, Kind: kind, Old: old, New: updated}
+
+	switch kind {
+	case CHANGE_REQUIRED_ADDED, CHANGE_NARROWED:
+		change.BreaksBackward = true
+	case CHANGE_REQUIRED_REMOVED, CHANGE_WIDENED:
+		change.BreaksForward = true
+	case CHANGE_CHANGED:
+		change.BreaksBackward = true
+		change.BreaksForward = true
+	}
+	c.addChange(change, inverted)
+}
+
+func (c *compatibilityChecker) compare(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	old = resolvedSchema(old)
+	updated = resolvedSchema(updated)
+
+	pair := compatibilityPair{old: old, updated: updated, inverted: inverted}
+	if c.visited[pair] {
+		return
+	}
+	c.visited[pair] = true
+
+	c.compareTypes(old, updated, location, inverted)
+	c.compareEnums(old, updated, location, inverted)
+
+	// number / integer
+	c.compareBounds(location, KEY_MINIMUM, old.minimum, old.exclusiveMinimum, updated.minimum, updated.exclusiveMinimum, false, inverted)
+	c.compareBounds(location, KEY_MAXIMUM, old.maximum, old.exclusiveMaximum, updated.maximum, updated.exclusiveMaximum, true, inverted)
+	c.compareMultipleOf(old, updated, location, inverted)
+
+	// string
+	c.compareBounds(location, KEY_MIN_LENGTH, intToFloat(old.minLength), false, intToFloat(updated.minLength), false, false, inverted)
+	c.compareBounds(location, KEY_MAX_LENGTH, intToFloat(old.maxLength), false, intToFloat(updated.maxLength), false, true, inverted)
+	c.compareStrings(location, KEY_PATTERN, regexpString(old.pattern), regexpString(updated.pattern), inverted)
+	c.compareStrings(location, KEY_FORMAT, old.format, updated.format, inverted)
+
+	// object
+	c.compareProperties(old, updated, location, inverted)
+	c.compareRequired(old, updated, location, inverted)
+	c.comparePatternProperties(old, updated, location, inverted)
+	c.compareDependencies(old, updated, location, inverted)
+	c.compareAdditional(location+"/"+KEY_ADDITIONAL_PROPERTIES, KEY_ADDITIONAL_PROPERTIES, old.additionalProperties, updated.additionalProperties, inverted)
+	c.compareBounds(location, KEY_MIN_PROPERTIES, intToFloat(old.minProperties), false, intToFloat(updated.minProperties), false, false, inverted)
+	c.compareBounds(location, KEY_MAX_PROPERTIES, intToFloat(old.maxProperties), false, intToFloat(updated.maxProperties), false, true, inverted)
+
+	// array
+	c.compareItems(old, updated, location, inverted)
+	c.compareAdditional(location+"/"+KEY_ADDITIONAL_ITEMS, KEY_ADDITIONAL_ITEMS, old.additionalItems, updated.additionalItems, inverted)
+	c.compareBounds(location, KEY_MIN_ITEMS, intToFloat(old.minItems), false, intToFloat(updated.minItems), false, false, inverted)
+	c.compareBounds(location, KEY_MAX_ITEMS, intToFloat(old.maxItems), false, intToFloat(updated.maxItems), false, true, inverted)
+	if old.uniqueItems != updated.uniqueItems {
+		kind := CHANGE_NARROWED
+		if old.uniqueItems {
+			kind = CHANGE_WIDENED
+		}
+		c.add(location+"/"+KEY_UNIQUE_ITEMS, KEY_UNIQUE_ITEMS, kind, old.uniqueItems, updated.uniqueItems, inverted)
+	}
+
+	// compositions
+	c.compareCompositions(location, KEY_ALL_OF, old.allOf, updated.allOf, inverted)
+	c.compareCompositions(location, KEY_ANY_OF, old.anyOf, updated.anyOf, inverted)
+	c.compareCompositions(location, KEY_ONE_OF, old.oneOf, updated.oneOf, inverted)
+	switch {
+	case old.not != nil && updated.not != nil:
+		c.compare(old.not, updated.not, location+"/"+KEY_NOT, !inverted)
+	case old.not != nil:
+		c.add(location+"/"+KEY_NOT, KEY_NOT, CHANGE_WIDENED, nil, nil, inverted)
+	case updated.not != nil:
+		c.add(location+"/"+KEY_NOT, KEY_NOT, CHANGE_NARROWED, nil, nil, inverted)
+	}
+}
+
+// acceptsType reports whether a list of types accepts t, no type accepting any
+func acceptsType(types []string, t string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	return isStringInSlice(types, t) || t == TYPE_INTEGER && isStringInSlice(types, TYPE_NUMBER)
+}
+
+func (c *compatibilityChecker) compareTypes(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	narrowed, widened := false, false
+	for _, t := range JSON_TYPES {
+		if acceptsType(old.types.types, t) && !acceptsType(updated.types.types, t) {
+			narrowed = true
+		}
+		if acceptsType(updated.types.types, t) && !acceptsType(old.types.types, t) {
+			widened = true
+		}
+	}
+
+	if kind := changeKind(narrowed, widened); kind != "" {
+		c.add(location+"/"+KEY_TYPE, KEY_TYPE, kind, typesValue(old.types.types), typesValue(updated.types.types), inverted)
+	}
+}
+
+// typesValue returns the types as written in a schema, nil for any type
+func typesValue(types []string) interface{} {
+	switch len(types) {
+	case 0:
+		return nil
+	case 1:
+		return types[0]
+	}
+	return types
+}
+
+func (c *compatibilityChecker) compareEnums(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	narrowed, widened := false, false
+	switch {
+	case old.enum == nil && updated.enum == nil:
+		return
+	case old.enum == nil:
+		narrowed = true
+	case updated.enum == nil:
+		widened = true
+	default:
+		oldValues, newValues := old.enumValues(), updated.enumValues()
+		for _, e := range oldValues {
+			if !containsJson(newValues, e) {
+				narrowed = true
+			}
+		}
+		for _, e := range newValues {
+			if !containsJson(oldValues, e) {
+				widened = true
+			}
+		}
+	}
+
+	if kind := changeKind(narrowed, widened); kind != "" {
+		c.add(location+"/"+KEY_ENUM, KEY_ENUM, kind, old.enumValues(), updated.enumValues(), inverted)
+	}
+}
+
+// containsJson reports whether values holds a value equal to v
+func containsJson(values []interface{}, v interface{}) bool {
+	for _, value := range values {
+		if jsonEqual(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareBounds compares limits, exclusive ones being tighter than inclusive ones
+func (c *compatibilityChecker) compareBounds(location string, keyword string, old *float64, oldExclusive bool, updated *float64, newExclusive bool, upper bool, inverted bool) {
+
+	kind := ""
+	switch {
+	case old == nil && updated == nil:
+		return
+	case old == nil:
+		kind = CHANGE_NARROWED
+	case updated == nil:
+		kind = CHANGE_WIDENED
+	case *old == *updated && oldExclusive == newExclusive:
+		return
+	case *old == *updated:
+		kind = CHANGE_WIDENED
+		if newExclusive {
+			kind = CHANGE_NARROWED
+		}
+	default:
+		tighter := *updated > *old
+		if upper {
+			tighter = *updated < *old
+		}
+		kind = CHANGE_WIDENED
+		if tighter {
+			kind = CHANGE_NARROWED
+		}
+	}
+
+	c.add(location+"/"+keyword, keyword, kind, boundValue(old), boundValue(updated), inverted)
+}
+
+func boundValue(f *float64) interface{} {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
+
+func intToFloat(i *int) *float64 {
+	if i == nil {
+		return nil
+	}
+	f := float64(*i)
+	return &f
+}
+
+func regexpString(r Regexp) string {
+	if r == nil {
+		return ""
+	}
+	return r.String()
+}
+
+func (c *compatibilityChecker) compareMultipleOf(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	kind := ""
+	switch {
+	case old.multipleOf == nil && updated.multipleOf == nil:
+		return
+	case old.multipleOf == nil:
+		kind = CHANGE_NARROWED
+	case updated.multipleOf == nil:
+		kind = CHANGE_WIDENED
+	case *old.multipleOf == *updated.multipleOf:
+		return
+	case isFloat64AnInteger(*updated.multipleOf / *old.multipleOf) && math.Abs(*updated.multipleOf) > math.Abs(*old.multipleOf):
+		kind = CHANGE_NARROWED
+	case isFloat64AnInteger(*old.multipleOf / *updated.multipleOf):
+		kind = CHANGE_WIDENED
+	default:
+		kind = CHANGE_CHANGED
+	}
+
+	c.add(location+"/"+KEY_MULTIPLE_OF, KEY_MULTIPLE_OF, kind, boundValue(old.multipleOf), boundValue(updated.multipleOf), inverted)
+}
+
+// compareStrings compares pattern and format : adding one narrows, removing one widens
+func (c *compatibilityChecker) compareStrings(location string, keyword string, old string, updated string, inverted bool) {
+
+	if old == updated {
+		return
+	}
+
+	var oldValue, newValue interface{}
+	kind := CHANGE_CHANGED
+	switch {
+	case old == "":
+		kind = CHANGE_NARROWED
+		newValue = updated
+	case updated == "":
+		kind = CHANGE_WIDENED
+		oldValue = old
+	default:
+		oldValue, newValue = old, updated
+	}
+
+	c.add(location+"/"+keyword, keyword, kind, oldValue, newValue, inverted)
+}
+
+// closed reports whether a schema rejects the properties it does not define
+func closed(s *subSchema) bool {
+	additional, ok := s.additionalProperties.(bool)
+	return ok && !additional && len(s.patternProperties) == 0
+}
+
+func (c *compatibilityChecker) compareProperties(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	oldProperties := propertiesByName(old)
+	newProperties := propertiesByName(updated)
+
+	for _, name := range sortedPropertyNames(oldProperties) {
+		propertyLocation := location + "/" + KEY_PROPERTIES + "/" + jsonPointerTokenEscaper.Replace(name)
+		if newProperty, ok := newProperties[name]; ok {
+			c.compare(oldProperties[name], newProperty, propertyLocation, inverted)
+			continue
+		}
+		change := CompatibilityChange{Location: propertyLocation, Keyword: KEY_PROPERTIES, Kind: CHANGE_PROPERTY_REMOVED, Old: name}
+		change.BreaksBackward = closed(updated)
+		change.BreaksForward = !closed(updated)
+		c.addChange(change, inverted)
+	}
+
+	for _, name := range sortedPropertyNames(newProperties) {
+		if _, ok := oldProperties[name]; ok {
+			continue
+		}
+		change := CompatibilityChange{
+			Location: location + "/" + KEY_PROPERTIES + "/" + jsonPointerTokenEscaper.Replace(name),
+			Keyword:  KEY_PROPERTIES,
+			Kind:     CHANGE_PROPERTY_ADDED,
+			New:      name,
+		}
+		change.BreaksBackward = !closed(old)
+		change.BreaksForward = closed(old)
+		c.addChange(change, inverted)
+	}
+}
+
+// addChange records a change whose breaks are set, inverting them under not
+func (c *compatibilityChecker) addChange(change CompatibilityChange, inverted bool) {
+	if inverted {
+		change.BreaksBackward, change.BreaksForward = change.BreaksForward, change.BreaksBackward
+	}
+	c.changes = append(c.changes, change)
+}
+
+func propertiesByName(s *subSchema) map[string]*subSchema {
+	properties := make(map[string]*subSchema, len(s.propertiesChildren))
+	for _, p := range s.propertiesChildren {
+		properties[p.property] = p
+	}
+	return properties
+}
+
+func sortedPropertyNames(properties map[string]*subSchema) []string {
+	names := make(map[string]interface{}, len(properties))
+	for name := range properties {
+		names[name] = nil
+	}
+	return sortedKeys(names)
+}
+
+func (c *compatibilityChecker) compareRequired(old *subSchema, updated *subSchema, location string, inverted bool) {
+	for _, name := range updated.required {
+		if !isStringInSlice(old.required, name) {
+			c.add(location+"/"+KEY_REQUIRED, KEY_REQUIRED, CHANGE_REQUIRED_ADDED, nil, name, inverted)
+		}
+	}
+	for _, name := range old.required {
+		if !isStringInSlice(updated.required, name) {
+			c.add(location+"/"+KEY_REQUIRED, KEY_REQUIRED, CHANGE_REQUIRED_REMOVED, name, nil, inverted)
+		}
+	}
+}
+
+// comparePatternProperties compares the schemas of a same pattern, adding or
+// removing a pattern changing the properties it matches
+func (c *compatibilityChecker) comparePatternProperties(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	for _, pattern := range sortedPropertyNames(old.patternProperties) {
+		patternLocation := location + "/" + KEY_PATTERN_PROPERTIES + "/" + jsonPointerTokenEscaper.Replace(pattern)
+		if newSchema, ok := updated.patternProperties[pattern]; ok {
+			c.compare(old.patternProperties[pattern], newSchema, patternLocation, inverted)
+			continue
+		}
+		c.add(patternLocation, KEY_PATTERN_PROPERTIES, CHANGE_CHANGED, pattern, nil, inverted)
+	}
+
+	for _, pattern := range sortedPropertyNames(updated.patternProperties) {
+		if _, ok := old.patternProperties[pattern]; !ok {
+			patternLocation := location + "/" + KEY_PATTERN_PROPERTIES + "/" + jsonPointerTokenEscaper.Replace(pattern)
+			c.add(patternLocation, KEY_PATTERN_PROPERTIES, CHANGE_CHANGED, nil, pattern, inverted)
+		}
+	}
+}
+
+// compareDependencies compares the dependencies of a same property : a
+// dependency added narrows, a dependency removed widens
+func (c *compatibilityChecker) compareDependencies(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	names := map[string]interface{}{}
+	for name := range old.dependencies {
+		names[name] = nil
+	}
+	for name := range updated.dependencies {
+		names[name] = nil
+	}
+
+	for _, name := range sortedKeys(names) {
+		dependencyLocation := location + "/" + KEY_DEPENDENCIES + "/" + jsonPointerTokenEscaper.Replace(name)
+		oldDependency, oldOk := old.dependencies[name]
+		newDependency, newOk := updated.dependencies[name]
+		oldSchema, oldIsSchema := oldDependency.(*subSchema)
+		newSchema, newIsSchema := newDependency.(*subSchema)
+		oldProperties, _ := oldDependency.([]string)
+		newProperties, _ := newDependency.([]string)
+
+		switch {
+		case !oldOk:
+			c.add(dependencyLocation, KEY_DEPENDENCIES, CHANGE_NARROWED, nil, dependencyValue(newDependency), inverted)
+		case !newOk:
+			c.add(dependencyLocation, KEY_DEPENDENCIES, CHANGE_WIDENED, dependencyValue(oldDependency), nil, inverted)
+		case oldIsSchema && newIsSchema:
+			c.compare(oldSchema, newSchema, dependencyLocation, inverted)
+		case oldIsSchema || newIsSchema:
+			c.add(dependencyLocation, KEY_DEPENDENCIES, CHANGE_CHANGED, dependencyValue(oldDependency), dependencyValue(newDependency), inverted)
+		default:
+			narrowed, widened := false, false
+			for _, property := range newProperties {
+				if !isStringInSlice(oldProperties, property) {
+					narrowed = true
+				}
+			}
+			for _, property := range oldProperties {
+				if !isStringInSlice(newProperties, property) {
+					widened = true
+				}
+			}
+			if kind := changeKind(narrowed, widened); kind != "" {
+				c.add(dependencyLocation, KEY_DEPENDENCIES, kind, oldProperties, newProperties, inverted)
+			}
+		}
+	}
+}
+
+// dependencyValue returns a dependency as written in a schema, schemas being named only
+func dependencyValue(v interface{}) interface{} {
+	if _, ok := v.(*subSchema); ok {
+		return STRING_SCHEMA
+	}
+	return v
+}
+
+// compareAdditional compares additionalProperties or additionalItems, nil
+// meaning true
+func (c *compatibilityChecker) compareAdditional(location string, keyword string, old interface{}, updated interface{}, inverted bool) {
+
+	oldSchema, oldIsSchema := old.(*subSchema)
+	newSchema, newIsSchema := updated.(*subSchema)
+	if oldIsSchema && newIsSchema {
+		c.compare(oldSchema, newSchema, location, inverted)
+		return
+	}
+
+	// any schema accepts less than true, more than false
+	rank := func(v interface{}, isSchema bool) int {
+		if isSchema {
+			return 1
+		}
+		if allowed, ok := v.(bool); ok && !allowed {
+			return 0
+		}
+		return 2
+	}
+	oldRank, newRank := rank(old, oldIsSchema), rank(updated, newIsSchema)
+
+	switch {
+	case newRank < oldRank:
+		c.add(location, keyword, CHANGE_NARROWED, additionalValue(old), additionalValue(updated), inverted)
+	case newRank > oldRank:
+		c.add(location, keyword, CHANGE_WIDENED, additionalValue(old), additionalValue(updated), inverted)
+	}
+}
+
+func additionalValue(v interface{}) interface{} {
+	if allowed, ok := v.(bool); ok {
+		return allowed
+	}
+	if v == nil {
+		return true
+	}
+	return STRING_SCHEMA
+}
+
+func (c *compatibilityChecker) compareItems(old *subSchema, updated *subSchema, location string, inverted bool) {
+
+	itemsLocation := location + "/" + KEY_ITEMS
+
+	switch {
+	case len(old.itemsChildren) == 0 && len(updated.itemsChildren) == 0:
+	case len(old.itemsChildren) == 0:
+		c.add(itemsLocation, KEY_ITEMS, CHANGE_NARROWED, nil, nil, inverted)
+	case len(updated.itemsChildren) == 0:
+		c.add(itemsLocation, KEY_ITEMS, CHANGE_WIDENED, nil, nil, inverted)
+	case old.itemsChildrenIsSingleSchema && updated.itemsChildrenIsSingleSchema:
+		c.compare(old.itemsChildren[0], updated.itemsChildren[0], itemsLocation, inverted)
+	case !old.itemsChildrenIsSingleSchema && !updated.itemsChildrenIsSingleSchema && len(old.itemsChildren) == len(updated.itemsChildren):
+		for i := range old.itemsChildren {
+			c.compare(old.itemsChildren[i], updated.itemsChildren[i], itemsLocation+"/"+strconv.Itoa(i), inverted)
+		}
+	default:
+		c.add(itemsLocation, KEY_ITEMS, CHANGE_CHANGED, nil, nil, inverted)
+	}
+}
+
+func (c *compatibilityChecker) compareCompositions(location string, keyword string, old []*subSchema, updated []*subSchema, inverted bool) {
+
+	compositionLocation := location + "/" + keyword
+
+	switch {
+	case len(old) == 0 && len(updated) == 0:
+	case len(old) == len(updated):
+		for i := range old {
+			c.compare(old[i], updated[i], compositionLocation+"/"+strconv.Itoa(i), inverted)
+		}
+	default:
+		c.add(compositionLocation, keyword, CHANGE_CHANGED, len(old), len(updated), inverted)
+	}
+}
+
+// changeKind returns the kind of a change accepting less and/or more values
+func changeKind(narrowed bool, widened bool) string {
+	switch {
+	case narrowed && widened:
+		return CHANGE_CHANGED
+	case narrowed:
+		return CHANGE_NARROWED
+	case widened:
+		return CHANGE_WIDENED
+	}
+	return ""
+}
+
+// pointerOrRoot returns a JSON Pointer, the root being written as (root)
+func pointerOrRoot(pointer string) string {
+	if pointer == "" {
+		return STRING_CONTEXT_ROOT
+	}
+	return pointer
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the compatibility of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+
+	oldSchema, err := NewSchema(NewStringLoader(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"name": {"type": "string", "maxLength": 10},
+			"status": {"enum": ["open", "closed"]},
+			"legacy": {"type": "string"},
+			"address": {"$ref": "#/definitions/address"}
+		},
+		"required": ["id"],
+		"definitions": {"address": {"properties": {"zip": {"type": "string"}}}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	newSchema, err := NewSchema(NewStringLoader(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "number"},
+			"name": {"type": "string", "maxLength": 5},
+			"status": {"enum": ["open"]},
+			"address": {"properties": {"zip": {"type": "string", "pattern": "^[0-9]+$"}}}
+		},
+		"required": ["id", "name"]
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	compatibility := CheckCompatibility(oldSchema, newSchema)
+	assert.False(t, compatibility.Backward())
+	assert.False(t, compatibility.Forward())
+
+	assert.Equal(t, []CompatibilityChange{
+		{Location: "/properties/address/properties/zip/pattern", Keyword: KEY_PATTERN, Kind: CHANGE_NARROWED, New: "^[0-9]+$", BreaksBackward: true},
+		{Location: "/properties/id/type", Keyword: KEY_TYPE, Kind: CHANGE_WIDENED, Old: TYPE_INTEGER, New: TYPE_NUMBER, BreaksForward: true},
+		{Location: "/properties/legacy", Keyword: KEY_PROPERTIES, Kind: CHANGE_PROPERTY_REMOVED, Old: "legacy", BreaksForward: true},
+		{Location: "/properties/name/maxLength", Keyword: KEY_MAX_LENGTH, Kind: CHANGE_NARROWED, Old: float64(10), New: float64(5), BreaksBackward: true},
+		{Location: "/properties/status/enum", Keyword: KEY_ENUM, Kind: CHANGE_NARROWED, Old: []interface{}{"open", "closed"}, New: []interface{}{"open"}, BreaksBackward: true},
+		{Location: "/required", Keyword: KEY_REQUIRED, Kind: CHANGE_REQUIRED_ADDED, New: "name", BreaksBackward: true},
+	}, compatibility.Changes())
+
+	assert.Equal(t, "/properties/name/maxLength: maxLength narrowed from 10 to 5 ( breaks backward compatibility )", compatibility.Changes()[3].String())
+	assert.Equal(t, "/properties/legacy: property removed ( breaks forward compatibility )", compatibility.Changes()[2].String())
+
+	// under not, accepting less values means accepting more
+	oldSchema, _ = NewSchema(NewStringLoader(`{"not": {"type": "string"}}`))
+	newSchema, _ = NewSchema(NewStringLoader(`{"not": {"type": ["string", "null"]}}`))
+	compatibility = CheckCompatibility(oldSchema, newSchema)
+	assert.False(t, compatibility.Backward())
+	assert.True(t, compatibility.Forward())
+
+	// recursive schemas
+	oldSchema, _ = NewSchema(NewStringLoader(`{"properties": {"children": {"items": {"$ref": "#"}}}}`))
+	compatibility = CheckCompatibility(oldSchema, oldSchema)
+	assert.True(t, compatibility.Full())
+	assert.Empty(t, compatibility.Changes())
+}
+
+func TestCheckCompatibilityPatternPropertiesAndDependencies(t *testing.T) {
+
+	oldSchema, err := NewSchema(NewStringLoader(`{
+		"properties": {"level": {"enum": [1, 2.5]}},
+		"patternProperties": {"^x-": {"type": "string"}, "^y-": {}},
+		"dependencies": {"a": ["b"], "c": {"required": ["d"]}, "e": ["f"]}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	newSchema, err := NewSchema(NewStringLoader(`{
+		"properties": {"level": {"enum": [1.0, 2.50]}},
+		"patternProperties": {"^x-": {"type": "integer"}, "^z-": {}},
+		"dependencies": {"a": ["b", "g"], "c": {"required": ["d", "h"]}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	var changes []string
+	for _, change := range CheckCompatibility(oldSchema, newSchema).Changes() {
+		changes = append(changes, change.String())
+	}
+	assert.Equal(t, []string{
+		"/patternProperties/^x-/type: type changed from string to integer ( breaks backward and forward compatibility )",
+		"/patternProperties/^y-: patternProperties changed ^y- ( breaks backward and forward compatibility )",
+		"/patternProperties/^z-: patternProperties changed ^z- ( breaks backward and forward compatibility )",
+		"/dependencies/a: dependencies narrowed from [b] to [b g] ( breaks backward compatibility )",
+		"/dependencies/c/required: required added h ( breaks backward compatibility )",
+		"/dependencies/e: dependencies widened [f] ( breaks forward compatibility )",
+	}, changes)
+}
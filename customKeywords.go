@@ -0,0 +1,94 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Extension point for custom schema keywords.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+type (
+	// Keyword is the interface of custom schema keywords, ie "uniqueBy": "id"
+	Keyword interface {
+		// Compile is called while parsing every schema holding the keyword,
+		// with the value of the keyword and the schema object holding it.
+		// A nil KeywordValidator means there is nothing to validate.
+		Compile(value interface{}, parent map[string]interface{}) (KeywordValidator, error)
+	}
+
+	// KeywordValidator validates an instance against a compiled custom keyword.
+	// Errors are reported with result.AddError, using the context given, which
+	// locates the value in the document.
+	KeywordValidator interface {
+		Validate(value interface{}, result *Result, context *JsonContext)
+	}
+
+	// KeywordChain holds custom keywords by name
+	KeywordChain struct {
+		keywords map[string]Keyword
+	}
+)
+
+var (
+	// CustomKeywords holds the custom keywords of the draft-04 dialect, they
+	// apply to every schema compiled afterwards.
+	// Keywords specific to some schemas are set on SchemaLoader.Keywords
+	CustomKeywords = KeywordChain{
+		keywords: map[string]Keyword{},
+	}
+)
+
+// Add adds a Keyword to the KeywordChain
+// The name used will be the keyword in the schema
+func (c *KeywordChain) Add(name string, k Keyword) *KeywordChain {
+	c.keywords[name] = k
+
+	return c
+}
+
+// Remove deletes a Keyword from the KeywordChain (if it exists)
+func (c *KeywordChain) Remove(name string) *KeywordChain {
+	delete(c.keywords, name)
+
+	return c
+}
+
+// Has checks to see if the KeywordChain holds a Keyword with the given name
+func (c *KeywordChain) Has(name string) bool {
+	_, ok := c.keywords[name]
+
+	return ok
+}
+
+// keywordsOf returns the custom keywords in effect for a SchemaLoader,
+// the ones of the loader taking precedence over CustomKeywords
+func keywordsOf(sl *SchemaLoader) map[string]Keyword {
+
+	keywords := make(map[string]Keyword, len(CustomKeywords.keywords)+len(sl.Keywords))
+	for name, k := range CustomKeywords.keywords {
+		keywords[name] = k
+	}
+	for name, k := range sl.Keywords {
+		keywords[name] = k
+	}
+
+	return keywords
+}
@@ -0,0 +1,124 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for custom keywords.
+//
+// created          18-10-2026
+
+package gojsonschema_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+type uniqueByError struct {
+	gojsonschema.ResultErrorFields
+}
+
+type uniqueByKeyword struct{}
+
+type uniqueByValidator struct {
+	key string
+}
+
+func (uniqueByKeyword) Compile(value interface{}, parent map[string]interface{}) (gojsonschema.KeywordValidator, error) {
+	key, ok := value.(string)
+	if !ok {
+		return nil, errors.New("uniqueBy must be a string")
+	}
+	if parent[gojsonschema.KEY_TYPE] != gojsonschema.TYPE_ARRAY {
+		return nil, errors.New("uniqueBy only applies to arrays")
+	}
+	return uniqueByValidator{key: key}, nil
+}
+
+func (u uniqueByValidator) Validate(value interface{}, result *gojsonschema.Result, context *gojsonschema.JsonContext) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+	seen := map[string]bool{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		k := fmt.Sprint(m[u.key])
+		if seen[k] {
+			err := &uniqueByError{}
+			err.SetType("unique_by")
+			err.SetDescription("%key% %value% is duplicated")
+			result.AddError(err, context, value, gojsonschema.ErrorDetails{"key": u.key, "value": k})
+		}
+		seen[k] = true
+	}
+}
+
+func TestCustomKeywords(t *testing.T) {
+
+	source := `{"type": "array", "uniqueBy": "id"}`
+
+	sl := gojsonschema.NewSchemaLoader()
+	sl.Strict = true
+	_, err := sl.Compile(gojsonschema.NewStringLoader(source))
+	assert.NotNil(t, err, "unregistered keywords are unknown in strict mode")
+
+	sl.Keywords = map[string]gojsonschema.Keyword{"uniqueBy": uniqueByKeyword{}}
+	schema, err := sl.Compile(gojsonschema.NewStringLoader(source))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(gojsonschema.NewStringLoader(`[{"id": 1}, {"id": 2}, {"id": 1}]`))
+	if !assert.Nil(t, err) || !assert.Len(t, result.Errors(), 1) {
+		return
+	}
+	assert.Equal(t, "unique_by", result.Errors()[0].Type())
+	assert.Equal(t, "id 1 is duplicated", result.Errors()[0].Description())
+	assert.IsType(t, &uniqueByError{}, result.Errors()[0])
+
+	result, err = schema.Validate(gojsonschema.NewStringLoader(`[{"id": 1}, {"id": 2}]`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+
+	_, err = sl.Compile(gojsonschema.NewStringLoader(`{"type": "object", "uniqueBy": "id"}`))
+	assert.EqualError(t, err, "uniqueBy only applies to arrays")
+
+	// keywords of the dialect apply to every schema
+	gojsonschema.CustomKeywords.Add("uniqueBy", uniqueByKeyword{})
+	defer gojsonschema.CustomKeywords.Remove("uniqueBy")
+	assert.True(t, gojsonschema.CustomKeywords.Has("uniqueBy"))
+
+	schema, err = gojsonschema.NewSchema(gojsonschema.NewStringLoader(`{"items": {"type": "array", "uniqueBy": "id"}}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err = schema.Validate(gojsonschema.NewStringLoader(`[[{"id": 1}, {"id": 1}]]`))
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "/0", result.Errors()[0].Context().JsonPointer())
+	}
+}
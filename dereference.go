@@ -0,0 +1,152 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Dereferencing of a compiled schema, for tools not supporting $ref.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// CycleMode is the behavior of Dereference on recursive references
+type CycleMode int
+
+const (
+	// CYCLE_ERROR fails on the first recursive reference
+	CYCLE_ERROR CycleMode = iota
+	// CYCLE_REF keeps recursive references, marked by KEY_CYCLE
+	CYCLE_REF
+)
+
+// KEY_CYCLE marks the $ref kept by Dereference where a schema recurses
+const KEY_CYCLE = STRING_EXTENSION_PREFIX + "cycle"
+
+// Dereference returns the schema document with every $ref replaced by the
+// schema it resolves to, following the links of the compiled schema.
+// A recursive schema cannot be fully dereferenced : depending on mode, the
+// first reference to an enclosing schema is an error, or every one is kept
+// with "x-cycle": true. The schemas kept references point to are written
+// under the definitions of the root, so that the document stands alone.
+func (d *Schema) Dereference(mode CycleMode) (map[string]interface{}, error) {
+
+	w := &schemaWriter{inlineReferences: true, inlining: map[string]bool{}}
+	c := &cycleTargets{root: d.rootSchema, rootLocation: referenceLocation(d.rootSchema.ref), references: map[string]string{}}
+	if mode == CYCLE_REF {
+		w.cycleMarker = KEY_CYCLE
+		w.cycleReference = c.reference
+	}
+
+	document := w.document(d.rootSchema, c.rootLocation)
+
+	if mode == CYCLE_ERROR && len(w.cycles) > 0 {
+		return nil, errors.New(formatErrorDescription(
+			Locale.RecursiveReference(),
+			ErrorDetails{"reference": w.cycles[0]},
+		))
+	}
+
+	// writing a target may find others
+	for len(c.pending) > 0 {
+		target := c.pending[0]
+		c.pending = c.pending[1:]
+		definitions, ok := document[KEY_DEFINITIONS].(map[string]interface{})
+		if !ok {
+			definitions = map[string]interface{}{}
+			document[KEY_DEFINITIONS] = definitions
+		}
+		definitions[target.name] = w.document(target.schema, target.location)
+	}
+
+	return document, nil
+}
+
+// cycleTargets names the schemas kept references point to, as definitions of the root
+type cycleTargets struct {
+	root         *subSchema
+	rootLocation string
+	// references holds the $ref written by absolute location
+	references map[string]string
+	taken      map[string]bool
+	pending    []cycleTarget
+}
+
+// cycleTarget is a schema to be written under the definitions of the root
+type cycleTarget struct {
+	name     string
+	location string
+	schema   *subSchema
+}
+
+// reference returns the $ref of the target found at location, relative to the root
+func (c *cycleTargets) reference(location string, target *subSchema) string {
+
+	if location == c.rootLocation {
+		return "#"
+	}
+	if reference, ok := c.references[location]; ok {
+		return reference
+	}
+
+	if c.taken == nil {
+		c.taken = map[string]bool{}
+		if c.root.refSchema == nil {
+			for name := range c.root.definitions {
+				c.taken[name] = true
+			}
+		}
+	}
+
+	// a definition of the root is written with it
+	prefix := c.rootLocation + "/" + KEY_DEFINITIONS + "/"
+	if token := strings.TrimPrefix(location, prefix); token != location && !strings.Contains(token, "/") && c.root.refSchema == nil {
+		if c.root.definitions[jsonPointerTokenUnescaper.Replace(token)] != nil {
+			c.references[location] = "#/" + KEY_DEFINITIONS + "/" + token
+			return c.references[location]
+		}
+	}
+
+	// named after the last token of its pointer, or its file
+	fragment := location[strings.Index(location, "#")+1:]
+	name := jsonPointerTokenUnescaper.Replace(fragment[strings.LastIndex(fragment, "/")+1:])
+	if name == "" {
+		file := path.Base(strings.TrimSuffix(location, "#"+fragment))
+		name = strings.TrimSuffix(file, path.Ext(file))
+	}
+	if name == "" || name == "." || name == "/" {
+		name = STRING_SCHEMA
+	}
+	free := name
+	for i := 2; c.taken[free]; i++ {
+		free = name + "_" + strconv.Itoa(i)
+	}
+	c.taken[free] = true
+
+	c.references[location] = "#/" + KEY_DEFINITIONS + "/" + jsonPointerTokenEscaper.Replace(free)
+	c.pending = append(c.pending, cycleTarget{name: free, location: location, schema: target})
+
+	return c.references[location]
+}
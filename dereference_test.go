@@ -0,0 +1,154 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the dereferencing of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDereference(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"home": {"$ref": "#/definitions/address"},
+			"work": {"$ref": "#/definitions/address"}
+		},
+		"definitions": {
+			"address": {"properties": {"city": {"$ref": "#/definitions/city"}}},
+			"city": {"type": "string"}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	dereferenced, err := schema.Dereference(CYCLE_ERROR)
+	assert.Nil(t, err)
+	document, err := json.Marshal(dereferenced)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"properties": {
+			"home": {"properties": {"city": {"type": "string"}}},
+			"work": {"properties": {"city": {"type": "string"}}}
+		},
+		"definitions": {
+			"address": {"properties": {"city": {"type": "string"}}},
+			"city": {"type": "string"}
+		}
+	}`, string(document))
+
+	recursive, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"name": {"type": "string"},
+			"children": {"type": "array", "items": {"$ref": "#"}}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	_, err = recursive.Dereference(CYCLE_ERROR)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "Reference # is recursive", err.Error())
+	}
+
+	dereferenced, err = recursive.Dereference(CYCLE_REF)
+	assert.Nil(t, err)
+	document, err = json.Marshal(dereferenced)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"properties": {
+			"name": {"type": "string"},
+			"children": {"type": "array", "items": {"$ref": "#", "x-cycle": true}}
+		}
+	}`, string(document))
+}
+
+func TestDereferenceCycleTargets(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"tree.json": `{
+			"properties": {"root": {"$ref": "#/definitions/node"}},
+			"definitions": {
+				"node": {
+					"properties": {
+						"children": {"type": "array", "items": {"$ref": "#/definitions/node"}},
+						"leaf": {"$ref": "leaf.json"}
+					}
+				}
+			}
+		}`,
+		"leaf.json": `{"properties": {"value": {"type": "integer"}, "next": {"$ref": "#"}}}`,
+	}
+	for name, content := range files {
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	}
+
+	schema, err := NewSchema(NewReferenceLoader("file://" + filepath.ToSlash(filepath.Join(dir, "tree.json"))))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	dereferenced, err := schema.Dereference(CYCLE_REF)
+	assert.Nil(t, err)
+	document, err := json.Marshal(dereferenced)
+	assert.Nil(t, err)
+
+	node := `{
+		"properties": {
+			"children": {"type": "array", "items": {"$ref": "#/definitions/node", "x-cycle": true}},
+			"leaf": {"properties": {"value": {"type": "integer"}, "next": {"$ref": "#/definitions/leaf", "x-cycle": true}}}
+		}
+	}`
+	assert.JSONEq(t, `{
+		"properties": {"root": `+node+`},
+		"definitions": {
+			"node": `+node+`,
+			"leaf": {"properties": {"value": {"type": "integer"}, "next": {"$ref": "#/definitions/leaf", "x-cycle": true}}}
+		}
+	}`, string(document))
+
+	// the kept references resolve in the document alone
+	dereferencedSchema, err := NewSchema(NewGoLoader(dereferenced))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err := dereferencedSchema.Validate(NewStringLoader(`{"root": {"children": [{"leaf": {"next": {"value": "a"}}}]}}`))
+	assert.Nil(t, err)
+	assert.False(t, result.Valid())
+}
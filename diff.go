@@ -0,0 +1,305 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Structural diff of two versions of a schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DiffOp is the operation of a DiffChange
+type DiffOp string
+
+const (
+	DIFF_ADDED   DiffOp = "added"
+	DIFF_REMOVED DiffOp = "removed"
+	DIFF_CHANGED DiffOp = "changed"
+)
+
+// DiffChange is a keyword, or a subschema, that differs between two versions
+// of a schema. Old and New are the values as written in a schema document,
+// nil when added or removed.
+type DiffChange struct {
+	Op       DiffOp
+	Location string
+	Old      interface{}
+	New      interface{}
+}
+
+// Diff returns the differences between two versions of a schema, keyword by
+// keyword, in the order of the locations.
+//
+// $ref are followed and compared by their targets, so moving a definition
+// is not a difference. definitions themselves are only compared through the
+// references to them. Subschemas of properties, items and compositions are
+// compared by name or position.
+func Diff(oldSchema *Schema, newSchema *Schema) []DiffChange {
+	d := &schemaDiff{comparing: map[[2]*subSchema]bool{}}
+	d.compare(oldSchema.referencedRoot(), newSchema.referencedRoot(), "")
+	return d.changes
+}
+
+// referencedRoot returns the subschema a $ref to the root resolves to, parsed
+// apart from the root, so that a recursion to the root is met as such
+func (d *Schema) referencedRoot() *subSchema {
+
+	reference, err := d.resolveReference(d.rootSchema, "#")
+	if err != nil {
+		return d.rootSchema
+	}
+
+	d.referencesMutex.Lock()
+	defer d.referencesMutex.Unlock()
+
+	if s, ok := d.referencePool.Get(reference.String()); ok {
+		return s
+	}
+	return d.rootSchema
+}
+
+type schemaDiff struct {
+	changes []DiffChange
+	// comparing holds the pairs being compared, from the root to the current one
+	comparing map[[2]*subSchema]bool
+}
+
+// value records the difference of two values of shallow documents, nil meaning absent
+func (d *schemaDiff) value(location string, old interface{}, updated interface{}) {
+	old, updated = writtenValue(old), writtenValue(updated)
+	switch {
+	case old == nil && updated == nil:
+	case old == nil:
+		d.changes = append(d.changes, DiffChange{Op: DIFF_ADDED, Location: location, New: updated})
+	case updated == nil:
+		d.changes = append(d.changes, DiffChange{Op: DIFF_REMOVED, Location: location, Old: old})
+	case !reflect.DeepEqual(old, updated):
+		d.changes = append(d.changes, DiffChange{Op: DIFF_CHANGED, Location: location, Old: old, New: updated})
+	}
+}
+
+func (d *schemaDiff) compare(old *subSchema, updated *subSchema, location string) {
+
+	old = resolvedSchema(old)
+	updated = resolvedSchema(updated)
+
+	// a pair met again below itself is a recursion
+	pair := [2]*subSchema{old, updated}
+	if d.comparing[pair] {
+		return
+	}
+	d.comparing[pair] = true
+	defer delete(d.comparing, pair)
+
+	// subschemas are compared one level at a time
+	w := &schemaWriter{shallow: true}
+	oldDocument := w.document(old, "")
+	updatedDocument := w.document(updated, "")
+
+	keys := map[string]interface{}{}
+	for k := range oldDocument {
+		keys[k] = nil
+	}
+	for k := range updatedDocument {
+		keys[k] = nil
+	}
+
+	for _, k := range sortedKeys(keys) {
+		keywordLocation := location + "/" + jsonPointerTokenEscaper.Replace(k)
+		oldValue, updatedValue := oldDocument[k], updatedDocument[k]
+
+		switch k {
+		case KEY_DEFINITIONS:
+			// compared through the references
+
+		case KEY_PROPERTIES:
+			d.compareNamed(keywordLocation, propertiesByName(old), propertiesByName(updated), oldValue, updatedValue)
+
+		case KEY_PATTERN_PROPERTIES:
+			d.compareNamed(keywordLocation, old.patternProperties, updated.patternProperties, oldValue, updatedValue)
+
+		case KEY_DEPENDENCIES:
+			oldDependencies, _ := oldValue.(map[string]interface{})
+			updatedDependencies, _ := updatedValue.(map[string]interface{})
+			names := map[string]interface{}{}
+			for name := range oldDependencies {
+				names[name] = nil
+			}
+			for name := range updatedDependencies {
+				names[name] = nil
+			}
+			for _, name := range sortedKeys(names) {
+				dependencyLocation := keywordLocation + "/" + jsonPointerTokenEscaper.Replace(name)
+				oldSchema, oldIsSchema := old.dependencies[name].(*subSchema)
+				updatedSchema, updatedIsSchema := updated.dependencies[name].(*subSchema)
+				if oldIsSchema && updatedIsSchema {
+					d.compare(oldSchema, updatedSchema, dependencyLocation)
+				} else {
+					d.value(dependencyLocation, oldDependencies[name], updatedDependencies[name])
+				}
+			}
+
+		case KEY_ITEMS:
+			switch {
+			case old.itemsChildrenIsSingleSchema && updated.itemsChildrenIsSingleSchema:
+				d.compare(old.itemsChildren[0], updated.itemsChildren[0], keywordLocation)
+			case len(old.itemsChildren) > 0 && !old.itemsChildrenIsSingleSchema && !updated.itemsChildrenIsSingleSchema && len(old.itemsChildren) == len(updated.itemsChildren):
+				d.compareList(keywordLocation, old.itemsChildren, updated.itemsChildren)
+			default:
+				d.value(keywordLocation, oldValue, updatedValue)
+			}
+
+		case KEY_ALL_OF, KEY_ANY_OF, KEY_ONE_OF:
+			oldList, updatedList := compositionOf(old, k), compositionOf(updated, k)
+			if len(oldList) == len(updatedList) {
+				d.compareList(keywordLocation, oldList, updatedList)
+			} else {
+				d.value(keywordLocation, oldValue, updatedValue)
+			}
+
+		case KEY_NOT:
+			if old.not != nil && updated.not != nil {
+				d.compare(old.not, updated.not, keywordLocation)
+			} else {
+				d.value(keywordLocation, oldValue, updatedValue)
+			}
+
+		case KEY_ADDITIONAL_PROPERTIES, KEY_ADDITIONAL_ITEMS:
+			oldSchema, oldIsSchema := old.additionalProperties.(*subSchema)
+			updatedSchema, updatedIsSchema := updated.additionalProperties.(*subSchema)
+			if k == KEY_ADDITIONAL_ITEMS {
+				oldSchema, oldIsSchema = old.additionalItems.(*subSchema)
+				updatedSchema, updatedIsSchema = updated.additionalItems.(*subSchema)
+			}
+			if oldIsSchema && updatedIsSchema {
+				d.compare(oldSchema, updatedSchema, keywordLocation)
+			} else {
+				d.value(keywordLocation, oldValue, updatedValue)
+			}
+
+		default:
+			d.value(keywordLocation, oldValue, updatedValue)
+		}
+	}
+}
+
+// compareNamed compares subschemas by name, the documents being the ones of the keyword
+func (d *schemaDiff) compareNamed(location string, old map[string]*subSchema, updated map[string]*subSchema, oldDocument interface{}, updatedDocument interface{}) {
+
+	oldDocuments, _ := oldDocument.(map[string]interface{})
+	updatedDocuments, _ := updatedDocument.(map[string]interface{})
+
+	names := map[string]interface{}{}
+	for name := range old {
+		names[name] = nil
+	}
+	for name := range updated {
+		names[name] = nil
+	}
+
+	for _, name := range sortedKeys(names) {
+		nameLocation := location + "/" + jsonPointerTokenEscaper.Replace(name)
+		if old[name] != nil && updated[name] != nil {
+			d.compare(old[name], updated[name], nameLocation)
+		} else {
+			d.value(nameLocation, oldDocuments[name], updatedDocuments[name])
+		}
+	}
+}
+
+func (d *schemaDiff) compareList(location string, old []*subSchema, updated []*subSchema) {
+	for i := range old {
+		d.compare(old[i], updated[i], location+"/"+strconv.Itoa(i))
+	}
+}
+
+// writtenValue returns a value of a shallow document with its subschemas written
+func writtenValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *subSchema:
+		return (&schemaWriter{}).document(value, "")
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = writtenValue(v)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(value))
+		for i, v := range value {
+			a[i] = writtenValue(v)
+		}
+		return a
+	}
+	return value
+}
+
+func compositionOf(s *subSchema, keyword string) []*subSchema {
+	switch keyword {
+	case KEY_ALL_OF:
+		return s.allOf
+	case KEY_ANY_OF:
+		return s.anyOf
+	}
+	return s.oneOf
+}
+
+// UnifiedDiff renders changes in the unified diff style, one hunk per
+// location, values being written as indented JSON
+func UnifiedDiff(oldName string, newName string, changes []DiffChange) string {
+
+	var b bytes.Buffer
+
+	if len(changes) == 0 {
+		return ""
+	}
+
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, change := range changes {
+		fmt.Fprintf(&b, "@@ %s @@\n", pointerOrRoot(change.Location))
+		if change.Op != DIFF_ADDED {
+			writeDiffValue(&b, "-", change.Old)
+		}
+		if change.Op != DIFF_REMOVED {
+			writeDiffValue(&b, "+", change.New)
+		}
+	}
+
+	return b.String()
+}
+
+func writeDiffValue(b *bytes.Buffer, prefix string, value interface{}) {
+	document, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		document = []byte(fmt.Sprintf("%v", value))
+	}
+	for _, line := range strings.Split(string(document), "\n") {
+		fmt.Fprintf(b, "%s%s\n", prefix, line)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the structural diff of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+
+	oldSchema, err := NewSchema(NewStringLoader(`{
+		"title": "Person",
+		"properties": {
+			"name": {"type": "string", "maxLength": 10},
+			"address": {"$ref": "#/definitions/address"},
+			"nickname": {"type": "string"}
+		},
+		"definitions": {"address": {"properties": {"city": {"type": "string"}}}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	// the address definition moves, and the schema recurses
+	newSchema, err := NewSchema(NewStringLoader(`{
+		"title": "Person",
+		"properties": {
+			"name": {"type": "string", "maxLength": 5},
+			"address": {"$ref": "#/definitions/location"},
+			"age": {"type": "integer"},
+			"friends": {"items": {"$ref": "#"}}
+		},
+		"definitions": {"location": {"properties": {"city": {"type": "string"}}}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	changes := Diff(oldSchema, newSchema)
+	assert.Equal(t, []DiffChange{
+		{Op: DIFF_ADDED, Location: "/properties/age", New: map[string]interface{}{"type": "integer"}},
+		{Op: DIFF_ADDED, Location: "/properties/friends", New: map[string]interface{}{"items": map[string]interface{}{"$ref": "#"}}},
+		{Op: DIFF_CHANGED, Location: "/properties/name/maxLength", Old: 10, New: 5},
+		{Op: DIFF_REMOVED, Location: "/properties/nickname", Old: map[string]interface{}{"type": "string"}},
+	}, changes)
+
+	assert.Equal(t, `--- v1.json
++++ v2.json
+@@ /properties/age @@
++{
++  "type": "integer"
++}
+@@ /properties/friends @@
++{
++  "items": {
++    "$ref": "#"
++  }
++}
+@@ /properties/name/maxLength @@
+-10
++5
+@@ /properties/nickname @@
+-{
+-  "type": "string"
+-}
+`, UnifiedDiff("v1.json", "v2.json", changes))
+
+	assert.Empty(t, Diff(newSchema, newSchema))
+	assert.Equal(t, "", UnifiedDiff("v1.json", "v2.json", nil))
+}
+
+func TestDiffSharedDefinition(t *testing.T) {
+
+	oldSchema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"home": {"$ref": "#/definitions/address"},
+			"work": {"$ref": "#/definitions/address"},
+			"parent": {"$ref": "#"}
+		},
+		"definitions": {"address": {"properties": {"city": {"type": "string"}}}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	newSchema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"home": {"$ref": "#/definitions/address"},
+			"work": {"$ref": "#/definitions/address"},
+			"parent": {"$ref": "#"}
+		},
+		"definitions": {"address": {"properties": {"city": {"type": "string", "minLength": 1}}}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	// a definition is compared at every location referencing it
+	assert.Equal(t, []DiffChange{
+		{Op: DIFF_ADDED, Location: "/properties/home/properties/city/minLength", New: 1},
+		{Op: DIFF_ADDED, Location: "/properties/work/properties/city/minLength", New: 1},
+	}, Diff(oldSchema, newSchema))
+}
@@ -135,10 +135,30 @@ type (
 	NumberLTError struct {
 		ResultErrorFields
 	}
+
+	// UnknownKeywordError. ErrorDetails: keyword
+	UnknownKeywordError struct {
+		ResultErrorFields
+	}
+
+	// InvalidRegexError. ErrorDetails: pattern, error
+	InvalidRegexError struct {
+		ResultErrorFields
+	}
+
+	// ReadOnlyError. ErrorDetails: property
+	ReadOnlyError struct {
+		ResultErrorFields
+	}
+
+	// WriteOnlyError. ErrorDetails: property
+	WriteOnlyError struct {
+		ResultErrorFields
+	}
 )
 
 // newError takes a ResultError type and sets the type, context, description, details, value, and field
-func newError(err ResultError, context *jsonContext, value interface{}, locale locale, details ErrorDetails) {
+func newError(err ResultError, context *JsonContext, value interface{}, locale locale, details ErrorDetails) {
 	var t string
 	var d string
 	switch err.(type) {
@@ -220,6 +240,22 @@ func newError(err ResultError, context *jsonContext, value interface{}, locale l
 	case *NumberLTError:
 		t = "number_lt"
 		d = locale.NumberLT()
+	case *UnknownKeywordError:
+		t = "unknown_keyword"
+		d = locale.UnknownKeyword()
+	case *InvalidRegexError:
+		t = "invalid_regex"
+		d = locale.RegexPattern()
+	case *ReadOnlyError:
+		t = "read_only"
+		d = locale.ReadOnly()
+	case *WriteOnlyError:
+		t = "write_only"
+		d = locale.WriteOnly()
+	default:
+		// custom keyword errors
+		t = err.Type()
+		d = err.Description()
 	}
 
 	err.SetType(t)
@@ -1,12 +1,15 @@
 package gojsonschema
 
 import (
+	"context"
+	"errors"
 	"net"
 	"net/url"
-	"reflect"
 	"regexp"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 type (
@@ -15,9 +18,30 @@ type (
 		IsFormat(input string) bool
 	}
 
+	// ContextFormatChecker is the interface of formatters needing more than a
+	// string to decide, ie "a date after the startDate property".
+	// A nil error means the input is valid, otherwise the error is given
+	// as the "error" detail of the DoesNotMatchFormatError.
+	ContextFormatChecker interface {
+		CheckFormat(ctx context.Context, input FormatInput) error
+	}
+
+	// FormatInput is the instance checked by a ContextFormatChecker
+	FormatInput struct {
+		// Value is the instance, of any JSON type ( numbers are json.Number )
+		Value interface{}
+		// Location is the JSON Pointer of the instance in the document, ie /dates/0/end
+		Location string
+		// Root is the whole document being validated
+		Root interface{}
+
+		regexpEngine RegexpEngine
+	}
+
 	// FormatCheckerChain holds the formatters
 	FormatCheckerChain struct {
-		formatters map[string]FormatChecker
+		formatters        map[string]FormatChecker
+		contextFormatters map[string]ContextFormatChecker
 	}
 
 	// EmailFormatter verifies email address formats
@@ -29,28 +53,22 @@ type (
 	// IPV6FormatChecker verifies IP addresses in the ipv6 format
 	IPV6FormatChecker struct{}
 
-	// DateTimeFormatChecker verifies date/time formats per RFC3339 5.6
+	// DateTimeFormatChecker verifies a date-time per RFC3339 5.6, ie 1985-04-12T23:20:50.52Z
 	//
-	// Valid formats:
-	// 		Partial Time: HH:MM:SS
-	//		Full Date: YYYY-MM-DD
-	// 		Full Time: HH:MM:SSZ-07:00
-	//		Date Time: YYYY-MM-DDTHH:MM:SSZ-0700
+	// 	date-time = full-date "T" full-time
+	//	full-date = YYYY-MM-DD
+	//	full-time = HH:MM:SS[.fraction]( Z / +HH:MM / -HH:MM )
 	//
-	// 	Where
-	//		YYYY = 4DIGIT year
-	//		MM = 2DIGIT month ; 01-12
-	//		DD = 2DIGIT day-month ; 01-28, 01-29, 01-30, 01-31 based on month/year
-	//		HH = 2DIGIT hour ; 00-23
-	//		MM = 2DIGIT ; 00-59
-	//		SS = 2DIGIT ; 00-58, 00-60 based on leap second rules
-	//		T = Literal
-	//		Z = Literal
+	// T and Z may be lowercase, any number of fractional digits is allowed and
+	// a leap second ( SS = 60 ) is valid at 23:59 UTC.
 	//
-	//	Note: Nanoseconds are also suported in all formats
+	// Lenient restores the behavior of earlier versions, also accepting a bare
+	// full-date, partial-time or full-time as parsed by time.Parse
 	//
 	// http://tools.ietf.org/html/rfc3339#section-5.6
-	DateTimeFormatChecker struct{}
+	DateTimeFormatChecker struct {
+		Lenient bool
+	}
 
 	// URIFormatCheckers validates a URI with a valid Scheme per RFC3986
 	URIFormatChecker struct{}
@@ -60,6 +78,43 @@ type (
 
 	// UUIDFormatChecker validates a UUID is in the correct format
 	UUIDFormatChecker struct{}
+
+	// RegexFormatChecker validates a regular expression can be compiled.
+	// Within a schema the RegexpEngine of the schema is used.
+	RegexFormatChecker struct{}
+
+	// DateFormatChecker validates a full-date per RFC3339 5.6, ie 2006-01-02
+	DateFormatChecker struct{}
+
+	// TimeFormatChecker validates a full-time per RFC3339 5.6, ie 15:04:05Z
+	TimeFormatChecker struct{}
+
+	// DurationFormatChecker validates a duration per RFC3339 Appendix A, ie P3DT4H
+	DurationFormatChecker struct{}
+
+	// URIReferenceFormatChecker validates a URI or a relative reference per RFC3986
+	URIReferenceFormatChecker struct{}
+
+	// URITemplateFormatChecker validates a URI template per RFC6570
+	URITemplateFormatChecker struct{}
+
+	// IRIFormatChecker validates an IRI with a valid Scheme per RFC3987
+	IRIFormatChecker struct{}
+
+	// IRIReferenceFormatChecker validates an IRI or a relative reference per RFC3987
+	IRIReferenceFormatChecker struct{}
+
+	// IDNEmailFormatChecker validates an internationalized email address per RFC6531
+	IDNEmailFormatChecker struct{}
+
+	// IDNHostnameFormatChecker validates an internationalized hostname per RFC5890
+	IDNHostnameFormatChecker struct{}
+
+	// JSONPointerFormatChecker validates a JSON Pointer per RFC6901
+	JSONPointerFormatChecker struct{}
+
+	// RelativeJSONPointerFormatChecker validates a Relative JSON Pointer
+	RelativeJSONPointerFormatChecker struct{}
 )
 
 var (
@@ -74,9 +129,26 @@ var (
 			"ipv6":      IPV6FormatChecker{},
 			"uri":       URIFormatChecker{},
 			"uuid":      UUIDFormatChecker{},
+
+			"date":                  DateFormatChecker{},
+			"time":                  TimeFormatChecker{},
+			"duration":              DurationFormatChecker{},
+			"uri-reference":         URIReferenceFormatChecker{},
+			"uri-template":          URITemplateFormatChecker{},
+			"iri":                   IRIFormatChecker{},
+			"iri-reference":         IRIReferenceFormatChecker{},
+			"idn-email":             IDNEmailFormatChecker{},
+			"idn-hostname":          IDNHostnameFormatChecker{},
+			"json-pointer":          JSONPointerFormatChecker{},
+			"relative-json-pointer": RelativeJSONPointerFormatChecker{},
+		},
+		contextFormatters: map[string]ContextFormatChecker{
+			"regex": RegexFormatChecker{},
 		},
 	}
 
+	errDoesNotMatchFormat = errors.New("does not match format")
+
 	// Regex credit: https://github.com/asaskevich/govalidator
 	rxEmail = regexp.MustCompile("^(((([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|((\\x22)((((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(([\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(\\([\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(\\x22)))@((([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|\\.|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|\\.|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$")
 
@@ -84,19 +156,39 @@ var (
 	rxHostname = regexp.MustCompile(`^([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])(\.([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9]))*$`)
 
 	rxUUID = regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+
+	rxDurationTime = `T(?:[0-9]+H(?:[0-9]+M(?:[0-9]+S)?)?|[0-9]+M(?:[0-9]+S)?|[0-9]+S)`
+	rxDuration     = regexp.MustCompile(`^P(?:(?:[0-9]+D|[0-9]+M(?:[0-9]+D)?|[0-9]+Y(?:[0-9]+M(?:[0-9]+D)?)?)(?:` + rxDurationTime + `)?|` + rxDurationTime + `|[0-9]+W)$`)
+
+	rxURITemplateVarSpec = `(?:[A-Za-z0-9_]|%[0-9A-Fa-f]{2})(?:\.?(?:[A-Za-z0-9_]|%[0-9A-Fa-f]{2}))*(?::[1-9][0-9]{0,3}|\*)?`
+	rxURITemplateExpr    = regexp.MustCompile(`^[+#./;?&=,!@|]?` + rxURITemplateVarSpec + `(?:,` + rxURITemplateVarSpec + `)*$`)
+
+	rxJSONPointer         = regexp.MustCompile(`^(?:/(?:[^/~]|~[01])*)*$`)
+	rxRelativeJSONPointer = regexp.MustCompile(`^(?:0|[1-9][0-9]*)(?:#|(?:/(?:[^/~]|~[01])*)*)$`)
 )
 
 // Add adds a FormatChecker to the FormatCheckerChain
 // The name used will be the value used for the format key in your json schema
 func (c *FormatCheckerChain) Add(name string, f FormatChecker) *FormatCheckerChain {
+	delete(c.contextFormatters, name)
 	c.formatters[name] = f
 
 	return c
 }
 
+// AddContext adds a ContextFormatChecker to the FormatCheckerChain,
+// replacing any FormatChecker of the same name
+func (c *FormatCheckerChain) AddContext(name string, f ContextFormatChecker) *FormatCheckerChain {
+	delete(c.formatters, name)
+	c.contextFormatters[name] = f
+
+	return c
+}
+
 // Remove deletes a FormatChecker from the FormatCheckerChain (if it exists)
 func (c *FormatCheckerChain) Remove(name string) *FormatCheckerChain {
 	delete(c.formatters, name)
+	delete(c.contextFormatters, name)
 
 	return c
 }
@@ -104,28 +196,53 @@ func (c *FormatCheckerChain) Remove(name string) *FormatCheckerChain {
 // Has checks to see if the FormatCheckerChain holds a FormatChecker with the given name
 func (c *FormatCheckerChain) Has(name string) bool {
 	_, ok := c.formatters[name]
+	_, okContext := c.contextFormatters[name]
 
-	return ok
+	return ok || okContext
 }
 
 // IsFormat will check an input against a FormatChecker with the given name
 // to see if it is the correct format
 func (c *FormatCheckerChain) IsFormat(name string, input interface{}) bool {
+
+	if f, ok := c.contextFormatters[name]; ok {
+		return f.CheckFormat(context.Background(), FormatInput{Value: input, Root: input}) == nil
+	}
+
 	f, ok := c.formatters[name]
 
 	if !ok {
 		return false
 	}
 
-	if !isKind(input, reflect.String) {
+	// json.Number is a string kind, only strings are checked
+	inputString, ok := input.(string)
+	if !ok {
 		return false
 	}
 
-	inputString := input.(string)
-
 	return f.IsFormat(inputString)
 }
 
+// checkFormat checks an instance of any type against a format.
+// FormatCheckers only check strings, values of other types are valid.
+func (c *FormatCheckerChain) checkFormat(ctx context.Context, name string, input FormatInput) error {
+
+	if f, ok := c.contextFormatters[name]; ok {
+		return f.CheckFormat(ctx, input)
+	}
+
+	if _, ok := input.Value.(string); !ok {
+		return nil
+	}
+
+	if !c.IsFormat(name, input.Value) {
+		return errDoesNotMatchFormat
+	}
+
+	return nil
+}
+
 func (f EmailFormatChecker) IsFormat(input string) bool {
 	return rxEmail.MatchString(input)
 }
@@ -143,6 +260,11 @@ func (f IPV6FormatChecker) IsFormat(input string) bool {
 }
 
 func (f DateTimeFormatChecker) IsFormat(input string) bool {
+
+	if !f.Lenient {
+		return isRFC3339DateTime(input)
+	}
+
 	formats := []string{
 		"15:04:05",
 		"15:04:05Z07:00",
@@ -176,3 +298,157 @@ func (f HostnameFormatChecker) IsFormat(input string) bool {
 func (f UUIDFormatChecker) IsFormat(input string) bool {
 	return rxUUID.MatchString(input)
 }
+
+func (f RegexFormatChecker) IsFormat(input string) bool {
+	_, err := regexp.Compile(input)
+	return err == nil
+}
+
+func (f RegexFormatChecker) CheckFormat(ctx context.Context, input FormatInput) error {
+
+	s, ok := input.Value.(string)
+	if !ok {
+		return nil
+	}
+
+	engine := input.regexpEngine
+	if engine == nil {
+		engine = GoRegexpEngine{}
+	}
+
+	// values of the documents are not kept in the caches of the engines
+	if c, ok := engine.(documentRegexpCompiler); ok {
+		_, err := c.compileDocumentRegexp(s)
+		return err
+	}
+
+	_, err := engine.Compile(s)
+	return err
+}
+
+func (f DateFormatChecker) IsFormat(input string) bool {
+	return isRFC3339FullDate(input)
+}
+
+func (f TimeFormatChecker) IsFormat(input string) bool {
+	return isRFC3339FullTime(input)
+}
+
+func (f DurationFormatChecker) IsFormat(input string) bool {
+	return rxDuration.MatchString(input)
+}
+
+func (f URIReferenceFormatChecker) IsFormat(input string) bool {
+	_, ok := parseURIReference(input, false)
+	return ok
+}
+
+func (f URITemplateFormatChecker) IsFormat(input string) bool {
+
+	for {
+		open := strings.IndexAny(input, "{}")
+		if open < 0 {
+			break
+		}
+		if input[open] == '}' {
+			return false
+		}
+		end := strings.IndexAny(input[open+1:], "{}")
+		if end < 0 || input[open+1+end] == '{' {
+			return false
+		}
+		if !rxURITemplateExpr.MatchString(input[open+1 : open+1+end]) {
+			return false
+		}
+		input = input[open+1+end+1:]
+	}
+
+	return true
+}
+
+func (f IRIFormatChecker) IsFormat(input string) bool {
+	u, ok := parseURIReference(input, true)
+	return ok && u.Scheme != ""
+}
+
+func (f IRIReferenceFormatChecker) IsFormat(input string) bool {
+	_, ok := parseURIReference(input, true)
+	return ok
+}
+
+func (f IDNEmailFormatChecker) IsFormat(input string) bool {
+	return rxEmail.MatchString(input)
+}
+
+func (f IDNHostnameFormatChecker) IsFormat(input string) bool {
+
+	if input == "" || utf8.RuneCountInString(input) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(input, ".") {
+		runes := []rune(label)
+		if len(runes) == 0 || len(runes) > 63 {
+			return false
+		}
+		if runes[0] == '-' || runes[len(runes)-1] == '-' || unicode.IsMark(runes[0]) {
+			return false
+		}
+		for _, r := range runes {
+			if r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (f JSONPointerFormatChecker) IsFormat(input string) bool {
+	return rxJSONPointer.MatchString(input)
+}
+
+func (f RelativeJSONPointerFormatChecker) IsFormat(input string) bool {
+	return rxRelativeJSONPointer.MatchString(input)
+}
+
+// parseURIReference parses a URI reference ( RFC3986 ) made of allowed
+// characters only, iri allows the non ASCII characters of IRIs ( RFC3987 )
+func parseURIReference(input string, iri bool) (*url.URL, bool) {
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case c >= 0x80:
+			if !iri {
+				return nil, false
+			}
+		case c == '%':
+			if i+2 >= len(input) || !isHexDigit(input[i+1]) || !isHexDigit(input[i+2]) {
+				return nil, false
+			}
+		case !strings.ContainsRune("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~:/?#[]@!$&'()*+,;=", rune(c)):
+			return nil, false
+		}
+	}
+
+	if strings.Count(input, "#") > 1 {
+		return nil, false
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		return nil, false
+	}
+
+	// an IPv6 host must be enclosed in brackets
+	if strings.Count(u.Host, ":") > 1 && !strings.HasPrefix(u.Host, "[") {
+		return nil, false
+	}
+
+	return u, true
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
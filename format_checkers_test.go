@@ -1,8 +1,13 @@
 package gojsonschema
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xeipuuv/gojsonpointer"
 )
 
 func TestUUIDFormatCheckerIsFormat(t *testing.T) {
@@ -14,3 +19,190 @@ func TestUUIDFormatCheckerIsFormat(t *testing.T) {
 	assert.False(t, checker.IsFormat("not-a-uuid"))
 	assert.False(t, checker.IsFormat("g1234567-89ab-cdef-0123-456789abcdef"))
 }
+
+type ctxKey struct{}
+
+// afterStartFormatChecker checks a date is after the startDate of the root document
+type afterStartFormatChecker struct{}
+
+func (afterStartFormatChecker) CheckFormat(ctx context.Context, input FormatInput) error {
+	if ctx.Value(ctxKey{}) != "checked" {
+		return errors.New("missing context")
+	}
+	pointer, err := gojsonpointer.NewJsonPointer("/startDate")
+	if err != nil {
+		return err
+	}
+	start, _, err := pointer.Get(input.Root)
+	if err != nil {
+		return err
+	}
+	end, ok := input.Value.(string)
+	if !ok || end <= start.(string) {
+		return errors.New(input.Location + " must be after the start date")
+	}
+	return nil
+}
+
+func TestContextFormatChecker(t *testing.T) {
+
+	FormatCheckers.AddContext("after-start", afterStartFormatChecker{})
+	defer FormatCheckers.Remove("after-start")
+	assert.True(t, FormatCheckers.Has("after-start"))
+
+	schema, err := NewSchema(NewStringLoader(`{"properties": {"dates": {"items": {"format": "after-start"}}}}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "checked")
+
+	result, err := schema.ValidateContext(ctx, NewStringLoader(`{"startDate": "2020-01-01", "dates": ["2020-02-01"]}`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+
+	result, err = schema.ValidateContext(ctx, NewStringLoader(`{"startDate": "2020-01-01", "dates": ["2020-02-01", "2019-01-01", 1]}`))
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 2) {
+		assert.Equal(t, "format", result.Errors()[0].Type())
+		assert.EqualError(t, result.Errors()[0].Details()["error"].(error), "/dates/1 must be after the start date")
+		assert.Equal(t, "/dates/2", result.Errors()[1].Context().JsonPointer())
+	}
+}
+
+func TestDateTimeFormatCheckerIsFormat(t *testing.T) {
+
+	tests := []struct {
+		input   string
+		valid   bool
+		lenient bool
+	}{
+		// RFC3339 5.8 examples
+		{"1985-04-12T23:20:50.52Z", true, true},
+		{"1996-12-19T16:39:57-08:00", true, true},
+		{"1990-12-31T23:59:60Z", true, false},
+		{"1990-12-31T15:59:60-08:00", true, false},
+		{"1937-01-01T12:00:27.87+00:20", true, true},
+
+		{"1985-04-12t23:20:50.52z", true, false},
+		{"2002-10-02T15:00:00.123456789012Z", true, true},
+		{"2020-02-29T00:00:00Z", true, true},
+		{"2019-02-29T00:00:00Z", false, false},
+		{"2000-04-31T00:00:00Z", false, false},
+		{"1990-12-31T23:59:60+01:00", false, false},
+		{"1998-12-31T23:59:61Z", false, false},
+		{"1998-12-31T24:00:00Z", false, false},
+		{"1998-13-31T00:00:00Z", false, false},
+		{"2002-10-02T15:00:00.Z", false, false},
+		{"2002-10-02T15:00:00", false, false},
+		{"2002-10-02T15:00:00+0100", false, false},
+		{"2002-10-02 15:00:00Z", false, false},
+		{"1963-06-19T08:30:06.28123+01:00Z", false, false},
+		{"2013-350T01:01:01", false, false},
+		{"2015-05-13", false, true},
+		{"05:15:37", false, true},
+		{"18:31:24-05:00", false, true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.valid, DateTimeFormatChecker{}.IsFormat(test.input), test.input)
+		assert.Equal(t, test.lenient, DateTimeFormatChecker{Lenient: true}.IsFormat(test.input), "lenient "+test.input)
+	}
+}
+
+func TestDateAndTimeFormatCheckerIsFormat(t *testing.T) {
+
+	dates := map[string]bool{
+		"1963-06-19":           true,
+		"2020-02-29":           true,
+		"2021-02-29":           false,
+		"1963-6-19":            false,
+		"1963-06-19T08:30:06Z": false,
+	}
+	for input, valid := range dates {
+		assert.Equal(t, valid, DateFormatChecker{}.IsFormat(input), input)
+	}
+
+	times := map[string]bool{
+		"08:30:06.283185Z": true,
+		"08:30:06+02:00":   true,
+		"23:59:60Z":        true,
+		"12:59:60Z":        false,
+		"08:30:06":         false,
+		"8:30:06Z":         false,
+		"08:30:06 PST":     false,
+	}
+	for input, valid := range times {
+		assert.Equal(t, valid, TimeFormatChecker{}.IsFormat(input), input)
+	}
+}
+
+func TestFormatAssertion(t *testing.T) {
+
+	source := `{
+		"properties": {
+			"email": {"format": "email"},
+			"id": {"format": "snowflake"},
+			"other": {"anyOf": [{"type": "integer", "format": "ipv4"}, {"format": "hostname"}]}
+		}
+	}`
+
+	_, err := NewSchema(NewStringLoader(source))
+	assert.NotNil(t, err, "unknown formats are compilation errors by default")
+
+	sl := NewSchemaLoader()
+	sl.UnknownFormats = UNKNOWN_FORMAT_IGNORE
+	schema, err := sl.Compile(NewStringLoader(source))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err := schema.Validate(NewStringLoader(`{"email": "not an email", "id": "1"}`))
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "/email", result.Errors()[0].Context().JsonPointer())
+	}
+	assert.Empty(t, result.Annotations())
+
+	sl.UnknownFormats = UNKNOWN_FORMAT_FAIL
+	schema, err = sl.Compile(NewStringLoader(source))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err = schema.Validate(NewStringLoader(`{"id": "1"}`))
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "format", result.Errors()[0].Type())
+	}
+
+	sl.FormatAssertion = false
+	sl.Annotations = true
+	schema, err = sl.Compile(NewStringLoader(source))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err = schema.Validate(NewStringLoader(`{"email": "not an email", "id": "1", "other": "x"}`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+	assert.Equal(t, map[string][]Annotation{
+		"/email": {{Location: "/email", Keyword: KEY_FORMAT, Value: "email"}},
+		"/id":    {{Location: "/id", Keyword: KEY_FORMAT, Value: "snowflake"}},
+		"/other": {{Location: "/other", Keyword: KEY_FORMAT, Value: "hostname"}},
+	}, result.Annotations())
+}
+
+func TestFormatIgnoresNumbers(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{"format": "email"}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	for _, document := range []string{`1`, `2.5`, `{"a": 1}`, `[1]`} {
+		result, err := schema.Validate(NewStringLoader(document))
+		if assert.Nil(t, err, document) {
+			assert.True(t, result.Valid(), document)
+		}
+	}
+
+	assert.False(t, FormatCheckers.IsFormat("email", json.Number("1")))
+}
@@ -0,0 +1,440 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generates Go types from a compiled Schema.
+//                  Walks the same subSchema tree used by the validation phase.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GoGenerator emits Go source code declaring the types described by a schema.
+//
+// Objects become structs, string and integer enums become typed constants,
+// optional properties become pointers and the root type gets a Validate method
+// checking a value against the schema it was generated from.
+type GoGenerator struct {
+	// PackageName is the package clause of the generated file
+	PackageName string
+	// TypeName is the name of the type generated for the root schema
+	TypeName string
+
+	buf        bytes.Buffer
+	named      map[string]string // resolved $ref => declared type name
+	declared   map[string]bool
+	interfaces map[string]bool // declared types whose underlying type is interface{}
+	rootName   string
+	wrapped    bool // the root type wraps its value in a struct
+}
+
+// NewGoGenerator returns a GoGenerator for the given package and root type name
+func NewGoGenerator(packageName string, typeName string) *GoGenerator {
+	return &GoGenerator{PackageName: packageName, TypeName: typeName}
+}
+
+// Generate returns the gofmt'ed source code for the types of the given schema
+func (g *GoGenerator) Generate(s *Schema) ([]byte, error) {
+
+	g.buf.Reset()
+	g.named = make(map[string]string)
+	g.declared = make(map[string]bool)
+	g.interfaces = make(map[string]bool)
+	g.wrapped = false
+
+	document, err := s.document()
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	rootName := g.identifier(g.TypeName, "Root")
+	g.rootName = rootName
+	// recursive references to the document itself ( "$ref": "#" ) use the root type,
+	// the ref of a root having a $ref being the one of its target
+	g.named[s.documentReference.String()] = rootName
+	rootType := g.goType(s.rootSchema, rootName)
+	if rootType != rootName {
+		g.declared[rootName] = true
+		g.declareType(rootName, rootType)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gojsonschema-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", g.PackageName)
+	fmt.Fprintf(&out, "import (\n")
+	if g.wrapped {
+		fmt.Fprintf(&out, "\t\"encoding/json\"\n")
+	}
+	fmt.Fprintf(&out, "\t\"sync\"\n\n\t\"github.com/xeipuuv/gojsonschema\"\n)\n\n")
+	out.Write(g.buf.Bytes())
+
+	schemaVar := unexportedIdentifier(rootName) + "Schema"
+	fmt.Fprintf(&out, "const %sSource = %s\n\n", schemaVar, goStringLiteral(string(source)))
+	fmt.Fprintf(&out, "var (\n\t%sOnce sync.Once\n\t%s *gojsonschema.Schema\n\t%sErr error\n)\n\n", schemaVar, schemaVar, schemaVar)
+	fmt.Fprintf(&out, "// Validate checks v against the schema %s was generated from\n", rootName)
+	fmt.Fprintf(&out, "func (v %s) Validate() (*gojsonschema.Result, error) {\n", rootName)
+	fmt.Fprintf(&out, "\t%sOnce.Do(func() {\n", schemaVar)
+	fmt.Fprintf(&out, "\t\t%s, %sErr = gojsonschema.NewSchema(gojsonschema.NewStringLoader(%sSource))\n", schemaVar, schemaVar, schemaVar)
+	fmt.Fprintf(&out, "\t})\n")
+	fmt.Fprintf(&out, "\tif %sErr != nil {\n\t\treturn nil, %sErr\n\t}\n\n", schemaVar, schemaVar)
+	fmt.Fprintf(&out, "\treturn %s.Validate(gojsonschema.NewGoLoader(v))\n}\n", schemaVar)
+
+	return format.Source(out.Bytes())
+}
+
+func (g *GoGenerator) printf(f string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, f, args...)
+}
+
+// goType returns the Go type expression for a subSchema.
+// Named types (structs, enums, referenced definitions) are declared on the fly
+// using name, or the name of the reference, as the type name.
+func (g *GoGenerator) goType(s *subSchema, name string) string {
+
+	if s.refSchema != nil {
+		ref := s.refSchema.ref.String()
+		if refName, ok := g.named[ref]; ok {
+			return refName
+		}
+		refName := g.identifier(referenceName(s.refSchema.ref.String()), name)
+		g.named[ref] = refName
+		g.declare(s.refSchema, refName)
+		return refName
+	}
+
+	if len(s.enum) > 0 || len(s.propertiesChildren) > 0 {
+		name = g.identifier(name, "")
+		g.declare(s, name)
+		return name
+	}
+
+	return g.builtinType(s, name)
+}
+
+func (g *GoGenerator) builtinType(s *subSchema, name string) string {
+
+	switch primaryType(s) {
+	case TYPE_OBJECT:
+		if additional, ok := s.additionalProperties.(*subSchema); ok {
+			return "map[string]" + g.goType(additional, name+"Value")
+		}
+		return "map[string]interface{}"
+	case TYPE_ARRAY:
+		if s.itemsChildrenIsSingleSchema {
+			return "[]" + g.goType(s.itemsChildren[0], name+"Item")
+		}
+		return "[]interface{}"
+	case TYPE_STRING:
+		return "string"
+	case TYPE_INTEGER:
+		return "int64"
+	case TYPE_NUMBER:
+		return "float64"
+	case TYPE_BOOLEAN:
+		return "bool"
+	}
+
+	return "interface{}"
+}
+
+// declare writes the declaration of a named type
+func (g *GoGenerator) declare(s *subSchema, name string) {
+
+	g.declared[name] = true
+
+	if len(s.enum) > 0 {
+		g.declareEnum(s, name)
+		return
+	}
+
+	if len(s.propertiesChildren) == 0 {
+		g.declareType(name, g.builtinType(s, name))
+		return
+	}
+
+	// Fields are written to a separate buffer as generating their types
+	// may declare other types
+	var fields bytes.Buffer
+	fieldNames := make(map[string]bool, len(goReservedMethods))
+	for _, method := range goReservedMethods {
+		fieldNames[method] = true
+	}
+
+	properties := make([]*subSchema, len(s.propertiesChildren))
+	copy(properties, s.propertiesChildren)
+	sort.Sort(subSchemasByProperty(properties))
+
+	for _, p := range properties {
+		fieldName := uniqueIdentifier(exportedIdentifier(p.property), fieldNames)
+		fieldNames[fieldName] = true
+		fieldType := g.goType(p, name+fieldName)
+		tag := p.property
+		if !isStringInSlice(s.required, p.property) {
+			tag += ",omitempty"
+			fieldType = pointerType(fieldType)
+		} else if resolvedSchema(p).types.Contains(TYPE_NULL) {
+			fieldType = pointerType(fieldType)
+		}
+		if description := resolvedSchema(p).description; description != nil {
+			fmt.Fprintf(&fields, "\t%s\n", goComment(*description))
+		}
+		fmt.Fprintf(&fields, "\t%s %s `json:%s`\n", fieldName, fieldType, strconv.Quote(tag))
+	}
+
+	if s.description != nil {
+		g.printf("%s\n", goComment(*s.description))
+	}
+	g.printf("type %s struct {\n%s}\n\n", name, fields.String())
+}
+
+func (g *GoGenerator) declareEnum(s *subSchema, name string) {
+
+	underlying := "interface{}"
+	values := []interface{}{}
+
+	for _, e := range s.enum {
+		value, err := decodeJsonUsingNumber(strings.NewReader(e))
+		if err != nil {
+			continue
+		}
+		values = append(values, value)
+	}
+
+	switch primaryType(s) {
+	case TYPE_STRING:
+		underlying = "string"
+	case TYPE_INTEGER:
+		underlying = "int64"
+	case TYPE_NUMBER:
+		underlying = "float64"
+	case TYPE_BOOLEAN:
+		underlying = "bool"
+	case "":
+		underlying = enumType(values)
+	}
+
+	if s.description != nil {
+		g.printf("%s\n", goComment(*s.description))
+	}
+	g.declareType(name, underlying)
+
+	if underlying != "string" && underlying != "int64" {
+		return
+	}
+
+	g.printf("const (\n")
+	for _, value := range values {
+		var constName, literal string
+		switch v := value.(type) {
+		case string:
+			if underlying != "string" {
+				continue
+			}
+			constName, literal = name+exportedIdentifier(v), strconv.Quote(v)
+		case json.Number:
+			if underlying != "int64" {
+				continue
+			}
+			// integers may be written 1.0 or 1e3 in the schema
+			r, ok := new(big.Rat).SetString(v.String())
+			if !ok || !r.IsInt() {
+				continue
+			}
+			literal = r.Num().String()
+			constName = name + strings.Replace(literal, "-", "Minus", 1)
+		default:
+			continue
+		}
+		constName = uniqueIdentifier(constName, g.declared)
+		g.declared[constName] = true
+		g.printf("\t%s %s = %s\n", constName, name, literal)
+	}
+	g.printf(")\n\n")
+}
+
+// declareType writes the declaration of a type defined by its underlying type.
+// The root type has a Validate method, it can not be an interface : it then
+// wraps its value in a struct written as the value itself.
+func (g *GoGenerator) declareType(name string, underlying string) {
+
+	isInterface := underlying == "interface{}" || g.interfaces[underlying]
+	if !isInterface || name != g.rootName {
+		g.interfaces[name] = isInterface
+		g.printf("type %s %s\n\n", name, underlying)
+		return
+	}
+
+	g.wrapped = true
+	g.printf("// %s holds a value of any type, marshaled as the value itself\n", name)
+	g.printf("type %s struct {\n\tValue %s\n}\n\n", name, underlying)
+	g.printf("// MarshalJSON writes the value held by v\n")
+	g.printf("func (v %s) MarshalJSON() ([]byte, error) {\n\treturn json.Marshal(v.Value)\n}\n\n", name)
+	g.printf("// UnmarshalJSON reads the value held by v\n")
+	g.printf("func (v *%s) UnmarshalJSON(data []byte) error {\n\treturn json.Unmarshal(data, &v.Value)\n}\n\n", name)
+}
+
+// identifier returns an exported identifier not yet used by a declaration
+func (g *GoGenerator) identifier(hint string, fallback string) string {
+
+	name := exportedIdentifier(hint)
+	if name == "X" && fallback != "" {
+		name = exportedIdentifier(fallback)
+	}
+
+	return uniqueIdentifier(name, g.declared)
+}
+
+// goReservedMethods are the methods of the generated types, no field can have their name
+var goReservedMethods = []string{"Validate"}
+
+// uniqueIdentifier returns name, followed by a number when name is already used
+func uniqueIdentifier(name string, used map[string]bool) string {
+
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+
+	return candidate
+}
+
+// primaryType returns the first non null type of a subSchema, or "" when untyped
+func primaryType(s *subSchema) string {
+	for _, t := range s.types.types {
+		if t != TYPE_NULL {
+			return t
+		}
+	}
+	return ""
+}
+
+func enumType(values []interface{}) string {
+
+	kind := ""
+	for _, value := range values {
+		var k string
+		switch v := value.(type) {
+		case string:
+			k = "string"
+		case json.Number:
+			if _, isValidInt64, _ := checkJsonNumber(v); isValidInt64 {
+				k = "int64"
+			} else {
+				k = "float64"
+			}
+		default:
+			return "interface{}"
+		}
+		if kind != "" && kind != k {
+			return "interface{}"
+		}
+		kind = k
+	}
+
+	if kind == "" {
+		return "interface{}"
+	}
+
+	return kind
+}
+
+func pointerType(t string) string {
+	if t == "interface{}" || strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") || strings.HasPrefix(t, "*") {
+		return t
+	}
+	return "*" + t
+}
+
+// referenceName returns the last token of a reference, ie Address for #/definitions/Address
+func referenceName(ref string) string {
+	if i := strings.LastIndexAny(ref, "/#"); i >= 0 {
+		return ref[i+1:]
+	}
+	return ref
+}
+
+// exportedIdentifier converts any property name into an exported Go identifier
+// ie first_name => FirstName
+func exportedIdentifier(s string) string {
+
+	var buf bytes.Buffer
+	upper := true
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if buf.Len() == 0 && unicode.IsDigit(r) {
+			buf.WriteRune('X')
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+
+	if buf.Len() == 0 {
+		return "X"
+	}
+
+	return buf.String()
+}
+
+func unexportedIdentifier(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func goComment(s string) string {
+	return "// " + strings.Replace(strings.TrimSpace(s), "\n", "\n// ", -1)
+}
+
+func goStringLiteral(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+type subSchemasByProperty []*subSchema
+
+func (s subSchemasByProperty) Len() int           { return len(s) }
+func (s subSchemasByProperty) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s subSchemasByProperty) Less(i, j int) bool { return s[i].property < s[j].property }
@@ -0,0 +1,268 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for Go code generation.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoGeneratorGenerate(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"type": "object",
+		"definitions": {
+			"address": {
+				"type": "object",
+				"properties": {"street": {"type": "string"}},
+				"required": ["street"]
+			}
+		},
+		"properties": {
+			"name": {"type": "string", "description": "Full name"},
+			"age": {"type": "integer"},
+			"color": {"type": "string", "enum": ["red", "light-blue"]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"home": {"$ref": "#/definitions/address"},
+			"work": {"$ref": "#/definitions/address"}
+		},
+		"required": ["name"]
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	source, err := NewGoGenerator("model", "Person").Generate(schema)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	assert.Nil(t, typeCheckGenerated(source))
+
+	code := string(source)
+	assert.Contains(t, code, "package model")
+	assert.Contains(t, code, "type Person struct {")
+	assert.Regexp(t, "Name +string +`json:\"name\"`", code)
+	assert.Regexp(t, "Age +\\*int64 +`json:\"age,omitempty\"`", code)
+	assert.Regexp(t, "Tags +\\[\\]string +`json:\"tags,omitempty\"`", code)
+	assert.Regexp(t, "Home +\\*Address", code)
+	assert.Regexp(t, "Work +\\*Address", code)
+	assert.Contains(t, code, "type Address struct {")
+	assert.Contains(t, code, "type PersonColor string")
+	assert.Regexp(t, "PersonColorLightBlue +PersonColor = \"light-blue\"", code)
+	assert.Contains(t, code, "// Full name")
+	assert.Contains(t, code, "func (v Person) Validate() (*gojsonschema.Result, error)")
+}
+
+func TestExportedIdentifier(t *testing.T) {
+	assert.Equal(t, "FirstName", exportedIdentifier("first_name"))
+	assert.Equal(t, "LightBlue", exportedIdentifier("light-blue"))
+	assert.Equal(t, "X2fa", exportedIdentifier("2fa"))
+	assert.Equal(t, "X", exportedIdentifier("$$"))
+}
+
+func TestGoGeneratorNameClashes(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"type": "object",
+		"properties": {
+			"first_name": {"type": "string"},
+			"firstName": {"type": "string"},
+			"a": {"type": "string"},
+			"A": {"type": "string"},
+			"Validate": {"type": "boolean"},
+			"level": {"type": "integer", "enum": [1.0, 2, 1e1, -3]},
+			"kind": {"type": "string", "enum": ["a-b", "a_b"]}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	source, err := NewGoGenerator("model", "Root").Generate(schema)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Nil(t, typeCheckGenerated(source))
+
+	code := string(source)
+	assert.Regexp(t, "FirstName +\\*string +`json:\"firstName,omitempty\"`", code)
+	assert.Regexp(t, "FirstName2 +\\*string +`json:\"first_name,omitempty\"`", code)
+	assert.Regexp(t, "A +\\*string +`json:\"A,omitempty\"`", code)
+	assert.Regexp(t, "A2 +\\*string +`json:\"a,omitempty\"`", code)
+	assert.Regexp(t, "Validate2 +\\*bool +`json:\"Validate,omitempty\"`", code)
+	assert.Regexp(t, "RootLevel1 +RootLevel = 1\n", code)
+	assert.Regexp(t, "RootLevel10 +RootLevel = 10\n", code)
+	assert.Regexp(t, "RootLevelMinus3 +RootLevel = -3\n", code)
+	assert.Regexp(t, "RootKindAB +RootKind = \"a-b\"", code)
+	assert.Regexp(t, "RootKindAB2 +RootKind = \"a_b\"", code)
+}
+
+func TestGoGeneratorRootType(t *testing.T) {
+
+	generate := func(source string) string {
+		schema, err := NewSchema(NewStringLoader(source))
+		if !assert.Nil(t, err, source) {
+			return ""
+		}
+		code, err := NewGoGenerator("model", "Root").Generate(schema)
+		if !assert.Nil(t, err, source) {
+			return ""
+		}
+		assert.Nil(t, typeCheckGenerated(code), "%s\n%s", source, code)
+		return string(code)
+	}
+
+	// an interface can not have the Validate method
+	code := generate(`{"items": {"type": "integer"}}`)
+	assert.Regexp(t, "type Root struct {\n\tValue interface{}\n}", code)
+	assert.Contains(t, code, "func (v Root) MarshalJSON() ([]byte, error)")
+	assert.Contains(t, code, "func (v *Root) UnmarshalJSON(data []byte) error")
+
+	code = generate(`{"$ref": "#/definitions/a", "definitions": {"a": {"type": "object", "properties": {"x": {"type": "string"}}}}}`)
+	assert.Contains(t, code, "type Root A\n")
+	assert.Contains(t, code, "type A struct {")
+	assert.NotContains(t, code, "encoding/json")
+
+	code = generate(`{"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#"}}}}`)
+	assert.Regexp(t, "Children +\\[\\]Root", code)
+}
+
+func TestGoGeneratorTestSuite(t *testing.T) {
+
+	forEachTestSuiteSchema(t, func(path string, schema *Schema) {
+		source, err := NewGoGenerator("model", "Root").Generate(schema)
+		if assert.Nil(t, err, path) {
+			assert.Nil(t, typeCheckGenerated(source), "%s\n%s", path, source)
+		}
+	})
+}
+
+// forEachTestSuiteSchema compiles the schemas of json_schema_test_suite,
+// the ones of refRemote are skipped as they load remote documents
+func forEachTestSuiteSchema(t *testing.T, f func(path string, schema *Schema)) {
+
+	paths, err := filepath.Glob("json_schema_test_suite/*/schema_*.json")
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	for _, path := range paths {
+		if filepath.Base(filepath.Dir(path)) == "refRemote" {
+			continue
+		}
+		abs, err := filepath.Abs(path)
+		if !assert.Nil(t, err) {
+			return
+		}
+		sl := NewSchemaLoader()
+		sl.UnknownFormats = UNKNOWN_FORMAT_IGNORE
+		schema, err := sl.Compile(NewReferenceLoader("file://" + filepath.ToSlash(abs)))
+		if assert.Nil(t, err, path) {
+			f(path, schema)
+		}
+	}
+}
+
+// testSuiteInstances returns the loaders of the instances of a schema of
+// json_schema_test_suite, data_12.json being the third one of schema_1.json
+func testSuiteInstances(path string) ([]string, []JSONLoader) {
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, nil
+	}
+
+	index := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "schema_"), ".json")
+	names, _ := filepath.Glob(filepath.Join(dir, "data_"+index+"?.json"))
+	loaders := make([]JSONLoader, len(names))
+	for i, name := range names {
+		loaders[i] = NewReferenceLoader("file://" + filepath.ToSlash(name))
+	}
+
+	return names, loaders
+}
+
+// typeCheckGenerated type checks generated source code, the packages it
+// imports being stubbed with the declarations it uses
+func typeCheckGenerated(source []byte) error {
+
+	stubs := map[string]string{
+		"encoding/json": `package json
+			func Marshal(v interface{}) ([]byte, error) { return nil, nil }
+			func Unmarshal(data []byte, v interface{}) error { return nil }`,
+		"sync": `package sync
+			type Once struct{}
+			func (o *Once) Do(f func()) {}`,
+		"github.com/xeipuuv/gojsonschema": `package gojsonschema
+			type JSONLoader interface{}
+			type Result struct{}
+			type Schema struct{}
+			func NewSchema(l JSONLoader) (*Schema, error) { return nil, nil }
+			func NewStringLoader(source string) JSONLoader { return nil }
+			func NewGoLoader(source interface{}) JSONLoader { return nil }
+			func (v *Schema) Validate(l JSONLoader) (*Result, error) { return nil, nil }`,
+	}
+
+	fset := token.NewFileSet()
+	check := func(path string, source interface{}, importer types.Importer) (*types.Package, error) {
+		file, err := parser.ParseFile(fset, path+".go", source, 0)
+		if err != nil {
+			return nil, err
+		}
+		config := types.Config{Importer: importer}
+		return config.Check(path, fset, []*ast.File{file}, nil)
+	}
+
+	packages := map[string]*types.Package{}
+	for path, stub := range stubs {
+		p, err := check(path, stub, nil)
+		if err != nil {
+			return err
+		}
+		packages[path] = p
+	}
+
+	_, err := check("model", source, stubImporter(packages))
+	return err
+}
+
+type stubImporter map[string]*types.Package
+
+func (i stubImporter) Import(path string) (*types.Package, error) {
+	if p, ok := i[path]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("no stub for %s", path)
+}
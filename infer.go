@@ -0,0 +1,265 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Infers a draft schema from sample documents.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"sort"
+)
+
+// SchemaInferrer infers a draft-04 schema from sample documents : the types
+// seen at every location, the properties of the objects merged together,
+// the items of the arrays merged into a single schema.
+//
+// Strings get a format when every sample matches one of the FormatCheckers,
+// or an enum when few distinct values are repeated across the samples.
+type SchemaInferrer struct {
+	// RequiredRatio is the share of the objects a property must appear in
+	// to be required, 1 when 0
+	RequiredRatio float64
+	// MaxEnumValues is the number of distinct strings above which no enum
+	// is inferred, 10 when 0, negative to never infer an enum
+	MaxEnumValues int
+
+	root *inferredNode
+}
+
+// inferredFormats are the formats tried on strings, the first matching every
+// sample being used. Formats matching most words, as hostname, are left out.
+var inferredFormats = []string{"date-time", "date", "time", "uuid", "email", "ipv4", "ipv6", "uri"}
+
+// inferredNode gathers the values seen at a location of the samples
+type inferredNode struct {
+	types map[string]int
+
+	// objects counts the objects, properties the values of their properties
+	objects    int
+	properties map[string]*inferredNode
+
+	items *inferredNode
+
+	// strings holds the distinct strings until there are too many of them,
+	// formats the formats matched by every string
+	stringCount int
+	strings     map[string]bool
+	tooMany     bool
+	formats     []string
+}
+
+// NewSchemaInferrer returns an inferrer with no samples
+func NewSchemaInferrer() *SchemaInferrer {
+	return &SchemaInferrer{}
+}
+
+// InferSchema infers a schema from the documents of the loaders
+func InferSchema(loaders ...JSONLoader) (map[string]interface{}, error) {
+	inferrer := NewSchemaInferrer()
+	for _, l := range loaders {
+		if err := inferrer.Add(l); err != nil {
+			return nil, err
+		}
+	}
+	return inferrer.Schema(), nil
+}
+
+// Add loads a document and adds it to the samples
+func (i *SchemaInferrer) Add(l JSONLoader) error {
+	document, err := l.LoadJSON()
+	if err != nil {
+		return err
+	}
+	if i.root == nil {
+		i.root = &inferredNode{}
+	}
+	i.root.add(document, i.maxEnumValues())
+	return nil
+}
+
+// Schema returns the schema inferred from the samples added so far, an
+// empty schema without samples
+func (i *SchemaInferrer) Schema() map[string]interface{} {
+	var schema map[string]interface{}
+	if i.root == nil {
+		schema = map[string]interface{}{}
+	} else {
+		schema = i.root.schema(i.requiredRatio(), i.maxEnumValues())
+	}
+	schema["$schema"] = draft04MetaSchemaUrl + "#"
+	return schema
+}
+
+func (i *SchemaInferrer) requiredRatio() float64 {
+	if i.RequiredRatio == 0 {
+		return 1
+	}
+	return i.RequiredRatio
+}
+
+func (i *SchemaInferrer) maxEnumValues() int {
+	if i.MaxEnumValues == 0 {
+		return 10
+	}
+	return i.MaxEnumValues
+}
+
+func (n *inferredNode) add(value interface{}, maxEnumValues int) {
+
+	if n.types == nil {
+		n.types = map[string]int{}
+	}
+	n.types[jsonType(value)]++
+
+	switch value := value.(type) {
+
+	case map[string]interface{}:
+		n.objects++
+		if n.properties == nil {
+			n.properties = map[string]*inferredNode{}
+		}
+		for name, property := range value {
+			if n.properties[name] == nil {
+				n.properties[name] = &inferredNode{}
+			}
+			n.properties[name].add(property, maxEnumValues)
+		}
+
+	case []interface{}:
+		for _, item := range value {
+			if n.items == nil {
+				n.items = &inferredNode{}
+			}
+			n.items.add(item, maxEnumValues)
+		}
+
+	case string:
+		if n.stringCount == 0 {
+			for _, format := range inferredFormats {
+				if FormatCheckers.Has(format) {
+					n.formats = append(n.formats, format)
+				}
+			}
+		}
+		n.stringCount++
+
+		formats := n.formats[:0]
+		for _, format := range n.formats {
+			if FormatCheckers.IsFormat(format, value) {
+				formats = append(formats, format)
+			}
+		}
+		n.formats = formats
+
+		if !n.tooMany {
+			if n.strings == nil {
+				n.strings = map[string]bool{}
+			}
+			n.strings[value] = true
+			if maxEnumValues < 0 || len(n.strings) > maxEnumValues {
+				n.tooMany = true
+				n.strings = nil
+			}
+		}
+	}
+}
+
+func (n *inferredNode) schema(requiredRatio float64, maxEnumValues int) map[string]interface{} {
+
+	schema := map[string]interface{}{}
+
+	types := make([]string, 0, len(n.types))
+	for t := range n.types {
+		// integers are numbers, both are seen as numbers
+		if t == TYPE_INTEGER && n.types[TYPE_NUMBER] > 0 {
+			continue
+		}
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	switch len(types) {
+	case 0:
+		return schema
+	case 1:
+		schema[KEY_TYPE] = types[0]
+	default:
+		schema[KEY_TYPE] = types
+	}
+
+	if n.objects > 0 {
+		properties := map[string]interface{}{}
+		required := []string{}
+		names := make([]string, 0, len(n.properties))
+		for name := range n.properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property := n.properties[name]
+			properties[name] = property.schema(requiredRatio, maxEnumValues)
+			if float64(property.count()) >= requiredRatio*float64(n.objects) {
+				required = append(required, name)
+			}
+		}
+		schema[KEY_PROPERTIES] = properties
+		if len(required) > 0 {
+			schema[KEY_REQUIRED] = required
+		}
+	}
+
+	if n.items != nil {
+		schema[KEY_ITEMS] = n.items.schema(requiredRatio, maxEnumValues)
+	}
+
+	if n.stringCount > 0 {
+		if len(n.formats) > 0 {
+			schema[KEY_FORMAT] = n.formats[0]
+		} else if !n.tooMany && n.stringCount >= 2*len(n.strings) {
+			// only strings may be enum values, enum would reject the other types
+			if len(types) == 1 {
+				values := make([]string, 0, len(n.strings))
+				for value := range n.strings {
+					values = append(values, value)
+				}
+				sort.Strings(values)
+				enum := make([]interface{}, len(values))
+				for i, value := range values {
+					enum[i] = value
+				}
+				schema[KEY_ENUM] = enum
+			}
+		}
+	}
+
+	return schema
+}
+
+// count is the number of values seen at the location
+func (n *inferredNode) count() int {
+	count := 0
+	for _, c := range n.types {
+		count += c
+	}
+	return count
+}
@@ -0,0 +1,126 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for schema inference.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func inferred(t *testing.T, inferrer *SchemaInferrer, documents ...string) string {
+	for _, document := range documents {
+		if !assert.Nil(t, inferrer.Add(NewStringLoader(document))) {
+			t.FailNow()
+		}
+	}
+	b, err := json.Marshal(inferrer.Schema())
+	assert.Nil(t, err)
+	return string(b)
+}
+
+func TestInferSchema(t *testing.T) {
+
+	schema := inferred(t, NewSchemaInferrer(),
+		`{"id": 1, "price": 2, "status": "open", "created": "2026-10-18T10:00:00Z", "tags": ["a"], "owner": {"email": "a@example.com"}}`,
+		`{"id": 2, "price": 2.5, "status": "closed", "created": "2026-10-18T11:00:00Z", "tags": [], "owner": null}`,
+		`{"id": 3, "price": 3, "status": "open", "created": "2026-10-18T12:00:00Z", "note": "first"}`,
+		`{"id": 4, "price": 1, "status": "open", "created": "2026-10-18T13:00:00Z", "note": "second"}`,
+	)
+
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"price": {"type": "number"},
+			"status": {"type": "string", "enum": ["closed", "open"]},
+			"created": {"type": "string", "format": "date-time"},
+			"note": {"type": "string"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"owner": {
+				"type": ["null", "object"],
+				"properties": {"email": {"type": "string", "format": "email"}},
+				"required": ["email"]
+			}
+		},
+		"required": ["created", "id", "price", "status"]
+	}`, schema)
+
+	inferrer := NewSchemaInferrer()
+	inferrer.RequiredRatio = 0.5
+	inferrer.MaxEnumValues = -1
+	schema = inferred(t, inferrer, `{"a": "x", "b": 1}`, `{"a": "x"}`, `{"a": "x", "c": true}`)
+
+	assert.JSONEq(t, `{
+		"$schema": "http://json-schema.org/draft-04/schema#",
+		"type": "object",
+		"properties": {"a": {"type": "string"}, "b": {"type": "integer"}, "c": {"type": "boolean"}},
+		"required": ["a"]
+	}`, schema)
+
+	empty, err := InferSchema()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"$schema": "http://json-schema.org/draft-04/schema#"}, empty)
+
+	_, err = InferSchema(NewStringLoader(`{`))
+	assert.NotNil(t, err)
+}
+
+func TestInferredSchemaValidatesSamples(t *testing.T) {
+
+	samples := [][]string{
+		{`{"a": "x@y.co"}`, `{"a": 2}`},
+		{`{"a": "2026-10-18T10:00:00Z"}`, `{"a": true}`, `{"a": null}`},
+		{`[1, "a", {"b": 1.5}]`, `[{"b": "c"}, []]`},
+		{`"open"`, `"closed"`, `3`},
+		{`{"a": {"b": [1]}}`, `{"a": {"b": "x"}, "c": 1}`, `{}`},
+	}
+
+	for _, documents := range samples {
+		loaders := make([]JSONLoader, len(documents))
+		for i, document := range documents {
+			loaders[i] = NewStringLoader(document)
+		}
+
+		inferredSchema, err := InferSchema(loaders...)
+		if !assert.Nil(t, err, "%v", documents) {
+			continue
+		}
+		schema, err := NewSchema(NewGoLoader(inferredSchema))
+		if !assert.Nil(t, err, "%v", documents) {
+			continue
+		}
+
+		for _, document := range documents {
+			result, err := schema.Validate(NewStringLoader(document))
+			if assert.Nil(t, err, document) {
+				assert.True(t, result.Valid(), "%s %v", document, result.Errors())
+			}
+		}
+	}
+}
@@ -0,0 +1,877 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generation of instances valid against a schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// InstanceGenerator generates instances valid against compiled schemas, for
+// tests and mocks. Instances are decoded JSON values : maps, slices, strings,
+// int64 for integers, float64 for other numbers, json.Number for enum values.
+//
+// Every instance is validated before being returned, the constraints the
+// generator does not solve ( not, oneOf matching more than one branch,
+// schema dependencies, ... ) being met by generating again.
+type InstanceGenerator struct {
+	// Minimal generates the smallest instances : only the required
+	// properties, the fewest items, the shortest strings, numbers closest to 0
+	Minimal bool
+	// MaxDepth is how many times a $ref is followed within itself before the
+	// generator keeps to the smallest instances, 2 when 0
+	MaxDepth int
+	// Attempts is the number of instances generated until one is valid, 20 when 0
+	Attempts int
+
+	rand *rand.Rand
+	// minimal is set while generating the first attempt of a Minimal generator
+	minimal bool
+	// depths counts the $ref targets being generated, deep those followed more than MaxDepth
+	depths map[string]int
+	deep   int
+	// varied is set while generating a value that must differ from the previous ones
+	varied int
+}
+
+// generatorDepthLimit is how many times a $ref is followed past MaxDepth
+// before it is reported as recursive
+const generatorDepthLimit = 16
+
+// NewInstanceGenerator returns a generator, the same seed giving the same
+// sequence of instances
+func NewInstanceGenerator(seed int64) *InstanceGenerator {
+	return &InstanceGenerator{rand: rand.New(rand.NewSource(seed))}
+}
+
+// Generate returns an instance valid against schema
+func (g *InstanceGenerator) Generate(schema *Schema) (interface{}, error) {
+
+	attempts := g.Attempts
+	if attempts <= 0 {
+		attempts = 20
+	}
+
+	var lastError error
+	for attempt := 0; attempt < attempts; attempt++ {
+
+		// attempts after the first are random, the minimal instance being the same every time
+		g.minimal = g.Minimal && attempt == 0
+		g.depths = map[string]int{}
+		g.deep = 0
+		g.varied = 0
+
+		instance, err := g.generate([]*subSchema{schema.rootSchema})
+		if err != nil {
+			lastError = err
+			continue
+		}
+
+		result, err := schema.Validate(NewGoLoader(instance))
+		if err != nil {
+			return nil, err
+		}
+		if result.Valid() {
+			return instance, nil
+		}
+		lastError = fmt.Errorf("%s", result.Errors()[0])
+	}
+
+	return nil, errors.New(formatErrorDescription(
+		Locale.NoValidInstance(),
+		ErrorDetails{"attempts": attempts, "error": lastError},
+	))
+}
+
+func (g *InstanceGenerator) isMinimal() bool {
+	return (g.minimal || g.deep > 0) && g.varied == 0
+}
+
+func (g *InstanceGenerator) maxDepth() int {
+	if g.MaxDepth <= 0 {
+		return 2
+	}
+	return g.MaxDepth
+}
+
+// intn returns a random int in [0, n), 0 when minimal
+func (g *InstanceGenerator) intn(n int) int {
+	if n <= 1 || g.isMinimal() {
+		return 0
+	}
+	return g.rand.Intn(n)
+}
+
+// generate returns a value valid against all the schemas
+func (g *InstanceGenerator) generate(schemas []*subSchema) (interface{}, error) {
+
+	members, entered, err := g.expand(schemas)
+	defer g.leave(entered)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok, err := g.enumValue(members); ok || err != nil {
+		return value, err
+	}
+
+	t, err := g.pickType(members)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case TYPE_NULL:
+		return nil, nil
+	case TYPE_BOOLEAN:
+		return g.intn(2) == 1, nil
+	case TYPE_INTEGER, TYPE_NUMBER:
+		value, err := g.number(members, t == TYPE_INTEGER)
+		if err != nil && t == TYPE_INTEGER {
+			// no integer in the bounds, maybe a number
+			for _, s := range members {
+				if !acceptsType(s.types.types, TYPE_NUMBER) {
+					return nil, err
+				}
+			}
+			return g.number(members, false)
+		}
+		return value, err
+	case TYPE_STRING:
+		return g.string(members)
+	case TYPE_ARRAY:
+		return g.array(members)
+	}
+	return g.object(members)
+}
+
+// expand returns the schemas a value must be valid against : $ref are
+// resolved, allOf flattened, and a branch of anyOf and oneOf chosen. entered
+// holds the $ref targets to leave once the value is generated.
+func (g *InstanceGenerator) expand(schemas []*subSchema) (members []*subSchema, entered []string, err error) {
+
+	seen := map[*subSchema]bool{}
+	queue := append([]*subSchema{}, schemas...)
+
+	for len(queue) > 0 {
+
+		s := queue[0]
+		queue = queue[1:]
+
+		if s.refSchema != nil {
+			location := referenceLocation(s.resolvedReference)
+			g.depths[location]++
+			entered = append(entered, location)
+			if g.depths[location] > g.maxDepth() {
+				g.deep++
+			}
+			if g.depths[location] > g.maxDepth()+generatorDepthLimit {
+				return nil, entered, errors.New(formatErrorDescription(
+					Locale.RecursiveReference(),
+					ErrorDetails{"reference": s.reference},
+				))
+			}
+			queue = append(queue, s.refSchema)
+			continue
+		}
+
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		members = append(members, s)
+
+		queue = append(queue, s.allOf...)
+		if len(s.anyOf) > 0 {
+			queue = append(queue, g.pickBranch(s.anyOf))
+		}
+		if len(s.oneOf) > 0 {
+			queue = append(queue, g.pickBranch(s.oneOf))
+		}
+	}
+
+	return members, entered, nil
+}
+
+func (g *InstanceGenerator) leave(entered []string) {
+	for _, location := range entered {
+		if g.depths[location] > g.maxDepth() {
+			g.deep--
+		}
+		g.depths[location]--
+	}
+}
+
+// pickBranch returns a branch of a composition, the first one without $ref
+// when minimal so that recursions end
+func (g *InstanceGenerator) pickBranch(branches []*subSchema) *subSchema {
+	if g.isMinimal() {
+		for _, branch := range branches {
+			if branch.refSchema == nil {
+				return branch
+			}
+		}
+	}
+	return branches[g.intn(len(branches))]
+}
+
+// enumValue returns a value of the enum of the members, allowed by all of them
+func (g *InstanceGenerator) enumValue(members []*subSchema) (interface{}, bool, error) {
+
+	var candidates []string
+	enumerated := false
+	for _, s := range members {
+		if s.enum == nil {
+			continue
+		}
+		if !enumerated {
+			candidates, enumerated = s.enum, true
+			continue
+		}
+		var kept []string
+		for _, e := range candidates {
+			if isStringInSlice(s.enum, e) {
+				kept = append(kept, e)
+			}
+		}
+		candidates = kept
+	}
+	if !enumerated {
+		return nil, false, nil
+	}
+
+	var values []interface{}
+	for _, e := range candidates {
+		value, err := decodeJsonUsingNumber(strings.NewReader(e))
+		if err != nil {
+			continue
+		}
+		allowed := true
+		for _, s := range members {
+			allowed = allowed && acceptsType(s.types.types, jsonType(value))
+		}
+		if allowed {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return nil, false, errors.New(formatErrorDescription(Locale.EmptyIntersection(), ErrorDetails{"keyword": KEY_ENUM}))
+	}
+
+	return values[g.intn(len(values))], true, nil
+}
+
+// generatorTypes are the types in the order the smallest instances prefer them
+var generatorTypes = []string{TYPE_NULL, TYPE_BOOLEAN, TYPE_INTEGER, TYPE_NUMBER, TYPE_STRING, TYPE_ARRAY, TYPE_OBJECT}
+
+// pickType returns a type allowed by all the members, preferring the ones
+// their keywords apply to
+func (g *InstanceGenerator) pickType(members []*subSchema) (string, error) {
+
+	var allowed, hinted []string
+	for _, t := range generatorTypes {
+		accepted := true
+		for _, s := range members {
+			accepted = accepted && acceptsType(s.types.types, t)
+		}
+		if !accepted {
+			continue
+		}
+		allowed = append(allowed, t)
+		for _, s := range members {
+			if hasKeywordsOfType(s, t) {
+				hinted = append(hinted, t)
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(allowed) == 0:
+		return "", errors.New(formatErrorDescription(Locale.EmptyIntersection(), ErrorDetails{"keyword": KEY_TYPE}))
+	case len(hinted) > 0:
+		allowed = hinted
+	case len(allowed) == len(generatorTypes) && !g.isMinimal():
+		// any value, scalars are enough
+		allowed = []string{TYPE_BOOLEAN, TYPE_INTEGER, TYPE_NUMBER, TYPE_STRING}
+	}
+
+	return allowed[g.intn(len(allowed))], nil
+}
+
+// hasKeywordsOfType tells if s has keywords validating values of type t
+func hasKeywordsOfType(s *subSchema, t string) bool {
+	switch t {
+	case TYPE_INTEGER, TYPE_NUMBER:
+		return s.minimum != nil || s.maximum != nil || s.multipleOf != nil
+	case TYPE_STRING:
+		return s.minLength != nil || s.maxLength != nil || s.pattern != nil || s.format != ""
+	case TYPE_ARRAY:
+		return s.itemsChildren != nil || s.additionalItems != nil || s.minItems != nil || s.maxItems != nil || s.uniqueItems
+	case TYPE_OBJECT:
+		return s.propertiesChildren != nil || s.patternProperties != nil || s.additionalProperties != nil ||
+			s.minProperties != nil || s.maxProperties != nil || s.required != nil || s.dependencies != nil
+	}
+	return false
+}
+
+func (g *InstanceGenerator) conflict(keyword string, other string) error {
+	return errors.New(formatErrorDescription(Locale.ConflictingKeywords(), ErrorDetails{"keyword": keyword, "other": other}))
+}
+
+func (g *InstanceGenerator) number(members []*subSchema, integer bool) (interface{}, error) {
+
+	lower, upper := math.Inf(-1), math.Inf(1)
+	lowerExclusive, upperExclusive := false, false
+	step := 0.0
+
+	for _, s := range members {
+		if s.minimum != nil {
+			if *s.minimum > lower {
+				lower, lowerExclusive = *s.minimum, s.exclusiveMinimum
+			} else if *s.minimum == lower {
+				lowerExclusive = lowerExclusive || s.exclusiveMinimum
+			}
+		}
+		if s.maximum != nil {
+			if *s.maximum < upper {
+				upper, upperExclusive = *s.maximum, s.exclusiveMaximum
+			} else if *s.maximum == upper {
+				upperExclusive = upperExclusive || s.exclusiveMaximum
+			}
+		}
+		if s.multipleOf != nil {
+			switch {
+			case step == 0 || isFloat64AnInteger(*s.multipleOf/step):
+				step = *s.multipleOf
+			case isFloat64AnInteger(step / *s.multipleOf):
+			default:
+				step *= *s.multipleOf
+			}
+		}
+	}
+
+	if integer {
+		if step == 0 {
+			step = 1
+		}
+		for k := 1.0; k <= 1000 && !isFloat64AnInteger(step); k++ {
+			if isFloat64AnInteger(step * k) {
+				step *= k
+			}
+		}
+	}
+
+	if step == 0 {
+		// any number in the bounds
+		a, b := lower, upper
+		switch {
+		case math.IsInf(a, -1) && math.IsInf(b, 1):
+			a, b = -100, 100
+		case math.IsInf(a, -1):
+			a = b - 100
+		case math.IsInf(b, 1):
+			b = a + 100
+		}
+		if a > b || a == b && (lowerExclusive || upperExclusive) {
+			return nil, g.conflict(KEY_MINIMUM, KEY_MAXIMUM)
+		}
+		v := math.Max(a, math.Min(b, 0))
+		if !g.isMinimal() {
+			v = math.Round((a+g.rand.Float64()*(b-a))*100) / 100
+		}
+		if v <= a && lowerExclusive || v >= b && upperExclusive || v < a || v > b {
+			v = (a + b) / 2
+		}
+		return v, nil
+	}
+
+	// a multiple of step in the bounds
+	kLower, kUpper := math.Inf(-1), math.Inf(1)
+	if !math.IsInf(lower, -1) {
+		kLower = math.Ceil(lower / step)
+		if lowerExclusive && kLower*step <= lower {
+			kLower++
+		}
+	}
+	if !math.IsInf(upper, 1) {
+		kUpper = math.Floor(upper / step)
+		if upperExclusive && kUpper*step >= upper {
+			kUpper--
+		}
+	}
+	switch {
+	case math.IsInf(kLower, -1) && math.IsInf(kUpper, 1):
+		kLower, kUpper = -100, 100
+	case math.IsInf(kLower, -1):
+		kLower = kUpper - 100
+	case math.IsInf(kUpper, 1):
+		kUpper = kLower + 100
+	}
+	if kLower > kUpper {
+		return nil, g.conflict(KEY_MINIMUM, KEY_MAXIMUM)
+	}
+
+	k := math.Max(kLower, math.Min(kUpper, 0))
+	if !g.isMinimal() {
+		k = kLower + float64(g.rand.Int63n(int64(math.Min(kUpper-kLower, 1<<20))+1))
+	}
+
+	v := k * step
+	// k * step is not always a multiple of step for floats, the shortest decimal often is
+	if rounded, err := strconv.ParseFloat(strconv.FormatFloat(v, 'f', decimals(step), 64), 64); err == nil && isFloat64AnInteger(rounded/step) {
+		v = rounded
+	}
+
+	if integer {
+		return int64(v), nil
+	}
+	return v, nil
+}
+
+// decimals returns the number of decimals of f written in the shortest form
+func decimals(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.Index(s, "."); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+func (g *InstanceGenerator) string(members []*subSchema) (interface{}, error) {
+
+	minLength, maxLength := 0, -1
+	format := ""
+	var pattern Regexp
+	for _, s := range members {
+		if s.minLength != nil && *s.minLength > minLength {
+			minLength = *s.minLength
+		}
+		if s.maxLength != nil && (maxLength < 0 || *s.maxLength < maxLength) {
+			maxLength = *s.maxLength
+		}
+		if format == "" {
+			format = s.format
+		}
+		if pattern == nil {
+			pattern = s.pattern
+		}
+	}
+	if maxLength >= 0 && minLength > maxLength {
+		return nil, g.conflict(KEY_MIN_LENGTH, KEY_MAX_LENGTH)
+	}
+
+	if generate, ok := formatGenerators[format]; ok {
+		return generate(g), nil
+	}
+	if pattern != nil {
+		// the lengths are checked, a few more strings being generated when needed
+		for tries := 0; tries < 10; tries++ {
+			s, err := g.matching(pattern.String())
+			if err != nil {
+				break
+			}
+			length := utf8.RuneCountInString(s)
+			if length >= minLength && (maxLength < 0 || length <= maxLength) || tries == 9 {
+				return s, nil
+			}
+			if tries == 0 {
+				g.varied++
+				defer func() { g.varied-- }()
+			}
+		}
+	}
+
+	length := minLength
+	if !g.isMinimal() {
+		longest := minLength + 8
+		if maxLength >= 0 && maxLength < longest {
+			longest = maxLength
+		}
+		length += g.intn(longest - minLength + 1)
+	}
+	return g.word(length), nil
+}
+
+// word returns length random lower case letters
+func (g *InstanceGenerator) word(length int) string {
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = byte('a' + g.intn(26))
+	}
+	return string(b)
+}
+
+// formatGenerators generate strings of the formats checked by default
+var formatGenerators = map[string]func(g *InstanceGenerator) string{
+	"date-time": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("%04d-%02d-%02dT%02d:%02d:%02dZ", 2000+g.intn(30), 1+g.intn(12), 1+g.intn(28), g.intn(24), g.intn(60), g.intn(60))
+	},
+	"date": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("%04d-%02d-%02d", 2000+g.intn(30), 1+g.intn(12), 1+g.intn(28))
+	},
+	"time": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("%02d:%02d:%02dZ", g.intn(24), g.intn(60), g.intn(60))
+	},
+	"duration": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("P%dD", 1+g.intn(30))
+	},
+	"email": func(g *InstanceGenerator) string {
+		return "a" + g.word(g.intn(8)) + "@example.com"
+	},
+	"idn-email": func(g *InstanceGenerator) string {
+		return "a" + g.word(g.intn(8)) + "@example.com"
+	},
+	"hostname": func(g *InstanceGenerator) string {
+		return "a" + g.word(g.intn(8)) + ".example.com"
+	},
+	"idn-hostname": func(g *InstanceGenerator) string {
+		return "a" + g.word(g.intn(8)) + ".example.com"
+	},
+	"ipv4": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("%d.%d.%d.%d", 1+g.intn(254), g.intn(256), g.intn(256), 1+g.intn(254))
+	},
+	"ipv6": func(g *InstanceGenerator) string {
+		return fmt.Sprintf("2001:db8::%x", 1+g.intn(0xfffe))
+	},
+	"uri": func(g *InstanceGenerator) string {
+		return "https://example.com/" + g.word(g.intn(8))
+	},
+	"iri": func(g *InstanceGenerator) string {
+		return "https://example.com/" + g.word(g.intn(8))
+	},
+	"uri-reference": func(g *InstanceGenerator) string {
+		return "/" + g.word(g.intn(8))
+	},
+	"iri-reference": func(g *InstanceGenerator) string {
+		return "/" + g.word(g.intn(8))
+	},
+	"uri-template": func(g *InstanceGenerator) string {
+		return "https://example.com/{" + "a" + g.word(g.intn(8)) + "}"
+	},
+	"uuid": func(g *InstanceGenerator) string {
+		b := make([]byte, 16)
+		for i := range b {
+			b[i] = byte(g.intn(256))
+		}
+		return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	},
+	"json-pointer": func(g *InstanceGenerator) string {
+		return "/" + g.word(g.intn(8))
+	},
+	"relative-json-pointer": func(g *InstanceGenerator) string {
+		return strconv.Itoa(g.intn(3)) + "/" + g.word(g.intn(8))
+	},
+	"regex": func(g *InstanceGenerator) string {
+		return "^" + g.word(g.intn(8)) + "$"
+	},
+}
+
+// matching returns a string matching a regular expression of the RE2 syntax
+func (g *InstanceGenerator) matching(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := g.writeMatching(&b, re); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// generatorRepeat is how many more times than their minimum *, + and {n,} repeat
+const generatorRepeat = 3
+
+func (g *InstanceGenerator) writeMatching(b *strings.Builder, re *syntax.Regexp) error {
+
+	repeat := func(min int, max int) error {
+		if max < 0 || max > min+generatorRepeat {
+			max = min + generatorRepeat
+		}
+		n := min + g.intn(max-min+1)
+		for i := 0; i < n; i++ {
+			if err := g.writeMatching(b, re.Sub[0]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return errors.New(formatErrorDescription(Locale.DoesNotMatchPattern(), ErrorDetails{"pattern": re.String()}))
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		b.WriteRune(g.classRune(re.Rune))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		b.WriteByte(byte('a' + g.intn(26)))
+	case syntax.OpCapture:
+		return g.writeMatching(b, re.Sub[0])
+	case syntax.OpStar:
+		return repeat(0, -1)
+	case syntax.OpPlus:
+		return repeat(1, -1)
+	case syntax.OpQuest:
+		return repeat(0, 1)
+	case syntax.OpRepeat:
+		return repeat(re.Min, re.Max)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := g.writeMatching(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return g.writeMatching(b, re.Sub[g.intn(len(re.Sub))])
+	}
+	// anchors, boundaries and empty matches write nothing
+	return nil
+}
+
+// classRune returns a rune of a class given as ranges, printable ASCII being preferred
+func (g *InstanceGenerator) classRune(ranges []rune) rune {
+
+	var printable []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < ' ' {
+			lo = ' '
+		}
+		if hi > '~' {
+			hi = '~'
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+	if len(ranges) < 2 {
+		return utf8.RuneError
+	}
+
+	i := 2 * g.intn(len(ranges)/2)
+	return ranges[i] + rune(g.intn(int(ranges[i+1]-ranges[i])+1))
+}
+
+func (g *InstanceGenerator) array(members []*subSchema) (interface{}, error) {
+
+	minItems, maxItems := 0, -1
+	unique := false
+	for _, s := range members {
+		if s.minItems != nil && *s.minItems > minItems {
+			minItems = *s.minItems
+		}
+		if s.maxItems != nil && (maxItems < 0 || *s.maxItems < maxItems) {
+			maxItems = *s.maxItems
+		}
+		if !s.itemsChildrenIsSingleSchema && s.additionalItems == false && (maxItems < 0 || len(s.itemsChildren) < maxItems) {
+			maxItems = len(s.itemsChildren)
+		}
+		unique = unique || s.uniqueItems
+	}
+	if maxItems >= 0 && minItems > maxItems {
+		return nil, g.conflict(KEY_MIN_ITEMS, KEY_MAX_ITEMS)
+	}
+
+	length := minItems
+	if !g.isMinimal() {
+		longest := minItems + generatorRepeat
+		if maxItems >= 0 && maxItems < longest {
+			longest = maxItems
+		}
+		length += g.intn(longest - minItems + 1)
+	}
+
+	items := make([]interface{}, 0, length)
+	for i := 0; i < length; i++ {
+
+		var schemas []*subSchema
+		for _, s := range members {
+			switch {
+			case s.itemsChildrenIsSingleSchema:
+				schemas = append(schemas, s.itemsChildren[0])
+			case i < len(s.itemsChildren):
+				schemas = append(schemas, s.itemsChildren[i])
+			default:
+				if additional, ok := s.additionalItems.(*subSchema); ok {
+					schemas = append(schemas, additional)
+				}
+			}
+		}
+
+		item, err := g.generate(schemas)
+		// a few more tries for an item not in the array yet
+		for tries := 0; err == nil && unique && isInSlice(items, item) && tries < 10; tries++ {
+			g.varied++
+			item, err = g.generate(schemas)
+			g.varied--
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func isInSlice(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *InstanceGenerator) object(members []*subSchema) (interface{}, error) {
+
+	minProperties, maxProperties := 0, -1
+	closed := false
+	var required, declared []string
+	var patterns []*subSchema
+	for _, s := range members {
+		if s.minProperties != nil && *s.minProperties > minProperties {
+			minProperties = *s.minProperties
+		}
+		if s.maxProperties != nil && (maxProperties < 0 || *s.maxProperties < maxProperties) {
+			maxProperties = *s.maxProperties
+		}
+		closed = closed || s.additionalProperties == false
+		for _, name := range s.required {
+			if !isStringInSlice(required, name) {
+				required = append(required, name)
+			}
+		}
+		for _, property := range s.propertiesChildren {
+			if !isStringInSlice(declared, property.property) {
+				declared = append(declared, property.property)
+			}
+		}
+		if len(s.patternProperties) > 0 {
+			patterns = append(patterns, s)
+		}
+	}
+	sort.Strings(declared)
+	if maxProperties >= 0 && minProperties > maxProperties {
+		return nil, g.conflict(KEY_MIN_PROPERTIES, KEY_MAX_PROPERTIES)
+	}
+
+	names := append([]string{}, required...)
+	full := func() bool {
+		return maxProperties >= 0 && len(names) >= maxProperties
+	}
+	var optional []string
+	for _, name := range declared {
+		if !isStringInSlice(names, name) {
+			optional = append(optional, name)
+		}
+	}
+	for _, name := range optional {
+		if !full() && g.intn(2) == 1 {
+			names = append(names, name)
+		}
+	}
+	for _, name := range optional {
+		if len(names) < minProperties && !isStringInSlice(names, name) {
+			names = append(names, name)
+		}
+	}
+	// still too few, named after the patterns or freely
+	for i := 1; len(names) < minProperties && i <= minProperties+generatorRepeat; i++ {
+		name := "property" + strconv.Itoa(i)
+		if len(patterns) > 0 {
+			pattern := sortedPatterns(patterns[0])[g.intn(len(patterns[0].patternProperties))]
+			if matching, err := g.matching(pattern); err == nil {
+				name = matching
+			}
+		} else if closed {
+			break
+		}
+		if !isStringInSlice(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	// property dependencies
+	for i := 0; i < len(names); i++ {
+		for _, s := range members {
+			if dependency, ok := s.dependencies[names[i]].([]string); ok {
+				for _, name := range dependency {
+					if !isStringInSlice(names, name) {
+						names = append(names, name)
+					}
+				}
+			}
+		}
+	}
+
+	object := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		value, err := g.generate(propertySchemas(members, name))
+		if err != nil {
+			return nil, err
+		}
+		object[name] = value
+	}
+
+	return object, nil
+}
+
+// propertySchemas returns the schemas the value of a property is validated against
+func propertySchemas(members []*subSchema, name string) []*subSchema {
+
+	var schemas []*subSchema
+	for _, s := range members {
+		declared := false
+		if property := propertiesByName(s)[name]; property != nil {
+			schemas = append(schemas, property)
+			declared = true
+		}
+		for _, pattern := range sortedPatterns(s) {
+			if s.patternPropertiesRegexps[pattern].MatchString(name) {
+				schemas = append(schemas, s.patternProperties[pattern])
+				declared = true
+			}
+		}
+		if additional, ok := s.additionalProperties.(*subSchema); ok && !declared {
+			schemas = append(schemas, additional)
+		}
+	}
+	return schemas
+}
@@ -0,0 +1,145 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the generation of valid instances.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const generatorTestSchema = `{
+	"definitions": {
+		"node": {
+			"type": "object",
+			"properties": {
+				"value": {"type": "integer", "minimum": 1, "maximum": 9},
+				"children": {"type": "array", "items": {"$ref": "#/definitions/node"}}
+			},
+			"required": ["value"]
+		}
+	},
+	"type": "object",
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"email": {"type": "string", "format": "email"},
+		"created": {"type": "string", "format": "date-time"},
+		"code": {"type": "string", "pattern": "^[A-Z]{3}-\\d{2,4}$"},
+		"name": {"type": "string", "minLength": 2, "maxLength": 5},
+		"price": {"type": "number", "minimum": 0, "exclusiveMinimum": true, "maximum": 10, "multipleOf": 0.01},
+		"status": {"enum": ["draft", "published"]},
+		"tags": {"type": "array", "items": {"type": "integer", "minimum": 0, "maximum": 5}, "minItems": 3, "uniqueItems": true},
+		"size": {"allOf": [{"type": "integer", "minimum": 2}, {"maximum": 4}]},
+		"shape": {"oneOf": [{"type": "string", "maxLength": 3}, {"type": "boolean"}]},
+		"tree": {"$ref": "#/definitions/node"}
+	},
+	"patternProperties": {"^x-[a-z]+$": {"type": "boolean"}},
+	"minProperties": 3,
+	"required": ["id", "code", "tree"]
+}`
+
+func TestInstanceGenerator(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(generatorTestSchema))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	g := NewInstanceGenerator(1)
+	for i := 0; i < 50; i++ {
+		instance, err := g.Generate(schema)
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		result, err := schema.Validate(NewGoLoader(instance))
+		assert.Nil(t, err)
+		assert.True(t, result.Valid())
+	}
+
+	// the same seed gives the same instances
+	first, second := NewInstanceGenerator(42), NewInstanceGenerator(42)
+	for i := 0; i < 5; i++ {
+		a, err := first.Generate(schema)
+		assert.Nil(t, err)
+		b, err := second.Generate(schema)
+		assert.Nil(t, err)
+		assert.Equal(t, a, b)
+	}
+}
+
+func TestInstanceGeneratorMinimal(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"definitions": {"list": {"type": "object", "properties": {"next": {"$ref": "#/definitions/list"}}, "required": ["next"]}},
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 3},
+			"count": {"type": "integer", "minimum": 5},
+			"ratio": {"type": "number", "maximum": -1, "exclusiveMaximum": true},
+			"items": {"type": "array", "minItems": 1, "items": {"type": ["null", "string"]}},
+			"list": {"oneOf": [{"$ref": "#/definitions/list"}, {"type": "null"}]},
+			"optional": {"type": "string"}
+		},
+		"required": ["name", "count", "ratio", "items", "list"]
+	}`))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	g := NewInstanceGenerator(1)
+	g.Minimal = true
+	instance, err := g.Generate(schema)
+	assert.Nil(t, err)
+	b, err := json.Marshal(instance)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"count":5,"items":[null],"list":null,"name":"aaa","ratio":-2}`, string(b))
+
+	// only infinite instances are valid
+	schema, err = NewSchema(NewStringLoader(`{"definitions": {"list": {"type": "object", "properties": {"next": {"$ref": "#/definitions/list"}}, "required": ["next"]}}, "$ref": "#/definitions/list"}`))
+	assert.Nil(t, err)
+	_, err = g.Generate(schema)
+	assert.NotNil(t, err)
+
+	schema, err = NewSchema(NewStringLoader(`{"type": "string", "allOf": [{"minLength": 4}, {"maxLength": 2}]}`))
+	assert.Nil(t, err)
+	_, err = g.Generate(schema)
+	assert.NotNil(t, err)
+}
+
+func TestInstanceGeneratorPatterns(t *testing.T) {
+
+	g := NewInstanceGenerator(7)
+	for _, pattern := range []string{`^[a-f0-9]{8}$`, `^(foo|bar)+\.json$`, `^\w+@\w+\.(com|org)$`, `[^a-z]{2}x?`, `^\p{Lu}\d*$`} {
+		re := regexp.MustCompile(pattern)
+		for i := 0; i < 20; i++ {
+			s, err := g.matching(pattern)
+			assert.Nil(t, err)
+			assert.True(t, re.MatchString(s), "%s does not match %s", s, pattern)
+		}
+	}
+}
@@ -0,0 +1,635 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Generation of instances violating a single keyword of a schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// InvalidInstance is a document violating a single keyword of a schema
+type InvalidInstance struct {
+	// Location is the JSON Pointer of the keyword in the schema document,
+	// absolute for the keywords of other documents
+	Location string
+	Keyword  string
+	// InstanceLocation is the JSON Pointer of the invalid value in Instance
+	InstanceLocation string
+	Instance         interface{}
+	// Error is the error validation reports for the keyword, ie *ArrayMaxItemsError
+	Error ResultError
+}
+
+// SkippedKeyword is a keyword GenerateInvalid found no document failing alone
+type SkippedKeyword struct {
+	// Location is the JSON Pointer of the keyword in the schema document,
+	// absolute for the keywords of other documents
+	Location string
+	Keyword  string
+	// InstanceLocation is the JSON Pointer of the value the keyword applies to
+	InstanceLocation string
+}
+
+// GenerateInvalid returns, for each keyword of the schema, a document
+// violating it and no other : a valid instance is generated, then the value
+// a keyword applies to is changed just enough to fail it.
+//
+// Keywords are reached through properties, patternProperties,
+// additionalProperties, items, additionalItems, allOf and $ref, a $ref within
+// itself being followed once. Keywords the mutations cannot fail alone, as
+// checked by validating every document, are returned as skipped.
+func (g *InstanceGenerator) GenerateInvalid(schema *Schema) ([]InvalidInstance, []SkippedKeyword, error) {
+
+	valid, err := g.Generate(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g.minimal = g.Minimal
+	g.depths = map[string]int{}
+	g.deep = 0
+	g.varied = 0
+
+	m := &mutator{
+		g:      g,
+		schema: schema,
+		root:   referenceLocation(schema.rootSchema.ref),
+		path:   map[string]bool{},
+	}
+	m.mutate(schema.rootSchema, m.root, "", valid, func(value interface{}) interface{} {
+		return value
+	})
+
+	return m.instances, m.skipped, nil
+}
+
+// mutator walks a schema along a valid instance, producing the invalid ones
+type mutator struct {
+	g      *InstanceGenerator
+	schema *Schema
+	// root is the location of the root schema
+	root string
+	// path holds the $ref targets being walked
+	path      map[string]bool
+	instances []InvalidInstance
+	skipped   []SkippedKeyword
+}
+
+// mutation is a keyword to fail, with the values to try in order
+type mutation struct {
+	keyword  string
+	values   []interface{}
+	expected ResultError
+	// loose accepts other errors, for the compositions that report the errors of their branches
+	loose bool
+}
+
+// compositionErrors are reported along the errors of the schemas of allOf, anyOf and oneOf
+var compositionErrors = []ResultError{new(NumberAllOfError), new(NumberAnyOfError), new(NumberOneOfError)}
+
+// mutate adds the invalid instances of the keywords of s, found at location,
+// valid being its value in the valid instance and wrap returning the whole
+// document for another value
+func (m *mutator) mutate(s *subSchema, location string, instanceLocation string, valid interface{}, wrap func(interface{}) interface{}) {
+
+	if s.refSchema != nil {
+		target := referenceLocation(s.resolvedReference)
+		if m.path[target] {
+			return
+		}
+		m.path[target] = true
+		defer delete(m.path, target)
+		m.mutate(s.refSchema, target, instanceLocation, valid, wrap)
+		return
+	}
+
+	for _, mu := range m.mutations(s, valid) {
+		m.try(location, instanceLocation, mu, wrap)
+	}
+
+	for i, branch := range s.allOf {
+		m.mutate(branch, location+"/"+KEY_ALL_OF+"/"+strconv.Itoa(i), instanceLocation, valid, wrap)
+	}
+
+	switch value := valid.(type) {
+	case map[string]interface{}:
+		m.mutateProperties(s, location, instanceLocation, value, wrap)
+	case []interface{}:
+		m.mutateItems(s, location, instanceLocation, value, wrap)
+	}
+}
+
+func (m *mutator) mutateProperties(s *subSchema, location string, instanceLocation string, object map[string]interface{}, wrap func(interface{}) interface{}) {
+
+	child := func(schema *subSchema, schemaLocation string, name string) {
+		value, ok := object[name]
+		if !ok {
+			if value, ok = m.generate(propertySchemas([]*subSchema{s}, name)); !ok {
+				return
+			}
+		}
+		m.mutate(schema, schemaLocation, instanceLocation+"/"+jsonPointerTokenEscaper.Replace(name), value, func(v interface{}) interface{} {
+			o := copyJson(object).(map[string]interface{})
+			o[name] = v
+			return wrap(o)
+		})
+	}
+
+	names := make([]string, 0, len(s.propertiesChildren))
+	for _, property := range s.propertiesChildren {
+		names = append(names, property.property)
+	}
+	sort.Strings(names)
+	properties := propertiesByName(s)
+	for _, name := range names {
+		child(properties[name], location+"/"+KEY_PROPERTIES+"/"+jsonPointerTokenEscaper.Replace(name), name)
+	}
+
+	for _, pattern := range sortedPatterns(s) {
+		name, err := m.g.matching(pattern)
+		if err != nil || properties[name] != nil {
+			continue
+		}
+		child(s.patternProperties[pattern], location+"/"+KEY_PATTERN_PROPERTIES+"/"+jsonPointerTokenEscaper.Replace(pattern), name)
+	}
+
+	if additional, ok := s.additionalProperties.(*subSchema); ok {
+		child(additional, location+"/"+KEY_ADDITIONAL_PROPERTIES, undeclaredProperty(s, object))
+	}
+}
+
+func (m *mutator) mutateItems(s *subSchema, location string, instanceLocation string, array []interface{}, wrap func(interface{}) interface{}) {
+
+	// child walks the schema of the item at index, the items before it being generated when missing
+	child := func(schema *subSchema, schemaLocation string, index int) {
+		items := copyJson(array).([]interface{})
+		for len(items) <= index {
+			item, ok := m.generate(itemSchemas(s, len(items)))
+			if !ok {
+				return
+			}
+			items = append(items, item)
+		}
+		m.mutate(schema, schemaLocation, instanceLocation+"/"+strconv.Itoa(index), items[index], func(v interface{}) interface{} {
+			a := copyJson(items).([]interface{})
+			a[index] = v
+			return wrap(a)
+		})
+	}
+
+	if s.itemsChildrenIsSingleSchema {
+		child(s.itemsChildren[0], location+"/"+KEY_ITEMS, 0)
+		return
+	}
+	for i, item := range s.itemsChildren {
+		child(item, location+"/"+KEY_ITEMS+"/"+strconv.Itoa(i), i)
+	}
+	if additional, ok := s.additionalItems.(*subSchema); ok && s.itemsChildren != nil {
+		child(additional, location+"/"+KEY_ADDITIONAL_ITEMS, len(s.itemsChildren))
+	}
+}
+
+// try adds the first value of mu failing its keyword alone, at instanceLocation
+func (m *mutator) try(location string, instanceLocation string, mu mutation, wrap func(interface{}) interface{}) {
+
+	expected := reflect.TypeOf(mu.expected)
+	keywordLocation := strings.TrimPrefix(location, m.root) + "/" + mu.keyword
+
+	for _, value := range mu.values {
+
+		document := wrap(value)
+		result, err := m.schema.Validate(NewGoLoader(document))
+		if err != nil || result.Valid() {
+			continue
+		}
+
+		var found ResultError
+		exact := true
+		for _, e := range result.Errors() {
+			switch {
+			case reflect.TypeOf(e) == expected && e.Context().JsonPointer() == instanceLocation:
+				if found == nil {
+					found = e
+				}
+			case !mu.loose && !isErrorOf(e, compositionErrors):
+				exact = false
+			}
+		}
+
+		if found != nil && exact {
+			m.instances = append(m.instances, InvalidInstance{
+				Location:         keywordLocation,
+				Keyword:          mu.keyword,
+				InstanceLocation: instanceLocation,
+				Instance:         document,
+				Error:            found,
+			})
+			return
+		}
+	}
+
+	m.skipped = append(m.skipped, SkippedKeyword{Location: keywordLocation, Keyword: mu.keyword, InstanceLocation: instanceLocation})
+}
+
+func isErrorOf(e ResultError, errors []ResultError) bool {
+	for _, other := range errors {
+		if reflect.TypeOf(e) == reflect.TypeOf(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// generate returns a value valid against the schemas, false when none is found
+func (m *mutator) generate(schemas []*subSchema) (interface{}, bool) {
+	value, err := m.g.generate(schemas)
+	return value, err == nil
+}
+
+// mutations returns the ways to fail the keywords of s, valid being a value valid against it
+func (m *mutator) mutations(s *subSchema, valid interface{}) []mutation {
+
+	var mutations []mutation
+	// a keyword without value to try is skipped
+	add := func(keyword string, expected ResultError, values ...interface{}) {
+		mutations = append(mutations, mutation{keyword: keyword, values: values, expected: expected})
+	}
+
+	if s.types.IsTyped() {
+		for _, t := range generatorTypes {
+			if !acceptsType(s.types.types, t) {
+				add(KEY_TYPE, new(InvalidTypeError), sampleOfType(t))
+				break
+			}
+		}
+	}
+
+	if len(s.enum) > 0 {
+		var values []interface{}
+		for _, value := range append(s.enumValues(), "invalid") {
+			for _, candidate := range []interface{}{differentValue(value), "invalid", int64(-1)} {
+				if j, err := marshalToJsonString(candidate); err == nil && !isStringInSlice(s.enum, *j) {
+					values = append(values, candidate)
+				}
+			}
+		}
+		add(KEY_ENUM, new(EnumError), values...)
+	}
+
+	m.numberMutations(s, valid, add)
+	m.stringMutations(s, valid, add)
+	m.arrayMutations(s, valid, add)
+	m.objectMutations(s, valid, add)
+
+	if len(s.anyOf) > 0 {
+		mutations = append(mutations, mutation{keyword: KEY_ANY_OF, values: sampleValues(), expected: new(NumberAnyOfError), loose: true})
+	}
+	if len(s.oneOf) > 0 {
+		values := sampleValues()
+		// valid against a branch, and maybe another
+		for _, branch := range s.oneOf {
+			if value, ok := m.generate([]*subSchema{branch}); ok {
+				values = append(values, value)
+			}
+		}
+		mutations = append(mutations, mutation{keyword: KEY_ONE_OF, values: values, expected: new(NumberOneOfError), loose: true})
+	}
+	if s.not != nil {
+		if value, ok := m.generate([]*subSchema{s.not}); ok {
+			add(KEY_NOT, new(NumberNotError), value)
+		}
+	}
+
+	return mutations
+}
+
+func (m *mutator) numberMutations(s *subSchema, valid interface{}, add func(string, ResultError, ...interface{})) {
+
+	step := 1.0
+	if s.multipleOf != nil {
+		step = *s.multipleOf
+	}
+
+	if s.minimum != nil {
+		// the closest multiple below the minimum, or at it when exclusive
+		below := math.Floor(*s.minimum/step) * step
+		if below >= *s.minimum && !s.exclusiveMinimum {
+			below -= step
+		}
+		if s.exclusiveMinimum {
+			add(KEY_MINIMUM, new(NumberGTError), jsonNumberValue(below), jsonNumberValue(*s.minimum))
+		} else {
+			add(KEY_MINIMUM, new(NumberGTEError), jsonNumberValue(below), jsonNumberValue(*s.minimum-1))
+		}
+	}
+
+	if s.maximum != nil {
+		above := math.Ceil(*s.maximum/step) * step
+		if above <= *s.maximum && !s.exclusiveMaximum {
+			above += step
+		}
+		if s.exclusiveMaximum {
+			add(KEY_MAXIMUM, new(NumberLTError), jsonNumberValue(above), jsonNumberValue(*s.maximum))
+		} else {
+			add(KEY_MAXIMUM, new(NumberLTEError), jsonNumberValue(above), jsonNumberValue(*s.maximum+1))
+		}
+	}
+
+	if s.multipleOf != nil {
+		base, _ := instanceNumber(valid)
+		add(KEY_MULTIPLE_OF, new(MultipleOfError),
+			jsonNumberValue(base+*s.multipleOf/2), jsonNumberValue(base+1), jsonNumberValue(base-1))
+	}
+}
+
+func (m *mutator) stringMutations(s *subSchema, valid interface{}, add func(string, ResultError, ...interface{})) {
+
+	str, _ := valid.(string)
+	runes := []rune(str)
+
+	if s.minLength != nil && *s.minLength > 0 {
+		var values []interface{}
+		if len(runes) >= *s.minLength {
+			values = append(values, string(runes[:*s.minLength-1]))
+		}
+		add(KEY_MIN_LENGTH, new(StringLengthGTEError), append(values, strings.Repeat("a", *s.minLength-1))...)
+	}
+
+	if s.maxLength != nil {
+		values := []interface{}{strings.Repeat("a", *s.maxLength+1)}
+		if len(runes) <= *s.maxLength {
+			values = append([]interface{}{str + strings.Repeat("a", *s.maxLength+1-len(runes))}, values...)
+		}
+		add(KEY_MAX_LENGTH, new(StringLengthLTEError), values...)
+	}
+
+	if s.pattern != nil {
+		var values []interface{}
+		for _, candidate := range []string{"", "~", str + "~", "~" + str, " ", "0", "a", "A"} {
+			if !s.pattern.MatchString(candidate) {
+				values = append(values, candidate)
+			}
+		}
+		add(KEY_PATTERN, new(DoesNotMatchPatternError), values...)
+	}
+
+	if s.format != "" && FormatCheckers.Has(s.format) {
+		var values []interface{}
+		for _, candidate := range []string{"~", "not a " + s.format, "%", ""} {
+			if !FormatCheckers.IsFormat(s.format, candidate) {
+				values = append(values, candidate)
+			}
+		}
+		add(KEY_FORMAT, new(DoesNotMatchFormatError), values...)
+	}
+}
+
+func (m *mutator) arrayMutations(s *subSchema, valid interface{}, add func(string, ResultError, ...interface{})) {
+
+	array, _ := valid.([]interface{})
+
+	// extended returns the valid items followed by generated ones, up to length
+	extended := func(length int) []interface{} {
+		items := copyJson(array).([]interface{})
+		for len(items) < length {
+			m.g.varied++
+			item, ok := m.generate(itemSchemas(s, len(items)))
+			m.g.varied--
+			if !ok {
+				return nil
+			}
+			items = append(items, item)
+		}
+		return items
+	}
+
+	if s.minItems != nil && *s.minItems > 0 && len(array) >= *s.minItems {
+		add(KEY_MIN_ITEMS, new(ArrayMinItemsError), copyJson(array[:*s.minItems-1]))
+	}
+
+	if s.maxItems != nil {
+		if items := extended(*s.maxItems + 1); items != nil {
+			add(KEY_MAX_ITEMS, new(ArrayMaxItemsError), items)
+		}
+	}
+
+	if s.uniqueItems {
+		if items := extended(1); items != nil {
+			add(KEY_UNIQUE_ITEMS, new(ItemsMustBeUniqueError), append(items, copyJson(items[0])))
+		}
+	}
+
+	if !s.itemsChildrenIsSingleSchema && s.itemsChildren != nil && s.additionalItems == false {
+		if items := extended(len(s.itemsChildren)); items != nil {
+			add(KEY_ADDITIONAL_ITEMS, new(ArrayNoAdditionalItemsError), append(items, nil))
+		}
+	}
+}
+
+func (m *mutator) objectMutations(s *subSchema, valid interface{}, add func(string, ResultError, ...interface{})) {
+
+	object, ok := valid.(map[string]interface{})
+	if !ok {
+		object = map[string]interface{}{}
+	}
+	names := sortedKeys(object)
+
+	for _, name := range s.required {
+		o := copyJson(object).(map[string]interface{})
+		delete(o, name)
+		add(KEY_REQUIRED, new(RequiredError), o)
+	}
+
+	if s.minProperties != nil && *s.minProperties > 0 {
+		o := copyJson(object).(map[string]interface{})
+		for _, name := range names {
+			if len(o) >= *s.minProperties && !isStringInSlice(s.required, name) {
+				delete(o, name)
+			}
+		}
+		add(KEY_MIN_PROPERTIES, new(ArrayMinPropertiesError), o)
+	}
+
+	if s.maxProperties != nil {
+		o := copyJson(object).(map[string]interface{})
+		for i := 1; len(o) <= *s.maxProperties && i <= *s.maxProperties+1; i++ {
+			name := undeclaredProperty(s, o)
+			if s.additionalProperties == false {
+				// declared properties only
+				for _, property := range s.propertiesChildren {
+					if _, ok := o[property.property]; !ok {
+						name = property.property
+						break
+					}
+				}
+			}
+			value, ok := m.generate(propertySchemas([]*subSchema{s}, name))
+			if !ok {
+				break
+			}
+			o[name] = value
+		}
+		add(KEY_MAX_PROPERTIES, new(ArrayMaxPropertiesError), o)
+	}
+
+	if s.additionalProperties == false {
+		added := copyJson(object).(map[string]interface{})
+		added[undeclaredProperty(s, added)] = nil
+		values := []interface{}{added}
+		// in place of an optional property, for maxProperties
+		for _, name := range names {
+			if !isStringInSlice(s.required, name) {
+				replaced := copyJson(object).(map[string]interface{})
+				delete(replaced, name)
+				replaced[undeclaredProperty(s, replaced)] = nil
+				values = append(values, replaced)
+				break
+			}
+		}
+		add(KEY_ADDITIONAL_PROPERTIES, new(AdditionalPropertyNotAllowedError), values...)
+	}
+
+	dependencies := make([]string, 0, len(s.dependencies))
+	for name := range s.dependencies {
+		dependencies = append(dependencies, name)
+	}
+	sort.Strings(dependencies)
+	for _, name := range dependencies {
+		dependency, ok := s.dependencies[name].([]string)
+		if !ok || len(dependency) == 0 {
+			continue
+		}
+		o := copyJson(object).(map[string]interface{})
+		if _, ok := o[name]; !ok {
+			value, ok := m.generate(propertySchemas([]*subSchema{s}, name))
+			if !ok {
+				continue
+			}
+			o[name] = value
+		}
+		for _, other := range dependency {
+			delete(o, other)
+		}
+		add(KEY_DEPENDENCIES, new(MissingDependencyError), o)
+	}
+}
+
+// itemSchemas returns the schemas of the item at index
+func itemSchemas(s *subSchema, index int) []*subSchema {
+	switch {
+	case s.itemsChildrenIsSingleSchema:
+		return []*subSchema{s.itemsChildren[0]}
+	case index < len(s.itemsChildren):
+		return []*subSchema{s.itemsChildren[index]}
+	}
+	if additional, ok := s.additionalItems.(*subSchema); ok {
+		return []*subSchema{additional}
+	}
+	return nil
+}
+
+// undeclaredProperty returns a name that is neither a property of s, matches
+// its patternProperties nor is in object
+func undeclaredProperty(s *subSchema, object map[string]interface{}) string {
+	name := "additionalProperty"
+	for i := 2; ; i++ {
+		_, taken := object[name]
+		if !taken && !isPropertyDeclared(s, name) {
+			return name
+		}
+		name = "additionalProperty" + strconv.Itoa(i)
+	}
+}
+
+// sampleOfType returns a small value of type t
+func sampleOfType(t string) interface{} {
+	switch t {
+	case TYPE_NULL:
+		return nil
+	case TYPE_BOOLEAN:
+		return false
+	case TYPE_INTEGER:
+		return int64(1)
+	case TYPE_NUMBER:
+		return 1.5
+	case TYPE_STRING:
+		return ""
+	case TYPE_ARRAY:
+		return []interface{}{}
+	}
+	return map[string]interface{}{}
+}
+
+// sampleValues returns a small value of every type
+func sampleValues() []interface{} {
+	values := make([]interface{}, len(generatorTypes))
+	for i, t := range generatorTypes {
+		values[i] = sampleOfType(t)
+	}
+	return values
+}
+
+// differentValue returns a value of the same type as value, but another one
+func differentValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return !v
+	case string:
+		return v + "_"
+	}
+	if f, ok := instanceNumber(value); ok {
+		return jsonNumberValue(f + 1)
+	}
+	return "invalid"
+}
+
+// instanceNumber returns a number of a generated instance or of an enum
+func instanceNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// jsonNumberValue returns f as an int64 when it is an integer, so that it is written as one
+func jsonNumberValue(f float64) interface{} {
+	if isFloat64AnInteger(f) {
+		return int64(f)
+	}
+	return f
+}
@@ -0,0 +1,148 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the generation of invalid instances.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateInvalid(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"definitions": {
+			"tag": {"type": "string", "minLength": 2, "maxLength": 8, "pattern": "^[a-z]+$"}
+		},
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"age": {"type": "integer", "minimum": 0, "maximum": 150},
+			"ratio": {"type": "number", "minimum": 0, "exclusiveMinimum": true, "maximum": 1, "exclusiveMaximum": true},
+			"step": {"type": "integer", "multipleOf": 5},
+			"status": {"enum": ["draft", "published"]},
+			"tags": {"type": "array", "items": {"$ref": "#/definitions/tag"}, "minItems": 1, "maxItems": 3, "uniqueItems": true},
+			"pair": {"type": "array", "items": [{"type": "integer"}, {"type": "boolean"}], "additionalItems": false},
+			"code": {"allOf": [{"type": "string"}, {"not": {"enum": ["root"]}}]},
+			"contact": {
+				"type": "object",
+				"properties": {"email": {"type": "string"}, "phone": {"type": "string"}},
+				"additionalProperties": false,
+				"minProperties": 1,
+				"maxProperties": 1,
+				"dependencies": {"phone": ["email"]}
+			},
+			"either": {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+			"exclusive": {"oneOf": [{"type": "integer"}, {"type": "number", "minimum": 0}]}
+		},
+		"required": ["id", "age"]
+	}`))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	g := NewInstanceGenerator(1)
+	g.Minimal = true
+	invalid, skipped, err := g.GenerateInvalid(schema)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	errorTypes := map[string]string{}
+	for _, i := range invalid {
+		errorTypes[i.Location] = reflect.TypeOf(i.Error).Elem().Name()
+
+		// only the keyword fails
+		result, err := schema.Validate(NewGoLoader(i.Instance))
+		assert.Nil(t, err)
+		assert.False(t, result.Valid())
+		assert.Equal(t, i.Error.Type(), result.Errors()[0].Type(), i.Location)
+		assert.Equal(t, i.InstanceLocation, i.Error.Context().JsonPointer(), i.Location)
+	}
+	// a phone fails dependencies and maxProperties too, tuple items are only
+	// validated when there are as many items as schemas
+	assert.Equal(t, []SkippedKeyword{
+		{Location: "/properties/contact/properties/phone/type", Keyword: KEY_TYPE, InstanceLocation: "/contact/phone"},
+		{Location: "/properties/pair/items/0/type", Keyword: KEY_TYPE, InstanceLocation: "/pair/0"},
+	}, skipped)
+
+	for location, errorType := range map[string]string{
+		"/type":                                    "InvalidTypeError",
+		"/required":                                "RequiredError",
+		"/properties/id/format":                    "DoesNotMatchFormatError",
+		"/properties/age/minimum":                  "NumberGTEError",
+		"/properties/age/maximum":                  "NumberLTEError",
+		"/properties/ratio/minimum":                "NumberGTError",
+		"/properties/ratio/maximum":                "NumberLTError",
+		"/properties/step/multipleOf":              "MultipleOfError",
+		"/properties/status/enum":                  "EnumError",
+		"/properties/tags/minItems":                "ArrayMinItemsError",
+		"/properties/tags/maxItems":                "ArrayMaxItemsError",
+		"/properties/tags/uniqueItems":             "ItemsMustBeUniqueError",
+		"/definitions/tag/minLength":               "StringLengthGTEError",
+		"/definitions/tag/maxLength":               "StringLengthLTEError",
+		"/definitions/tag/pattern":                 "DoesNotMatchPatternError",
+		"/properties/pair/additionalItems":         "ArrayNoAdditionalItemsError",
+		"/properties/code/allOf/0/type":            "InvalidTypeError",
+		"/properties/code/allOf/1/not":             "NumberNotError",
+		"/properties/contact/additionalProperties": "AdditionalPropertyNotAllowedError",
+		"/properties/contact/minProperties":        "ArrayMinPropertiesError",
+		"/properties/contact/maxProperties":        "ArrayMaxPropertiesError",
+		"/properties/contact/dependencies":         "MissingDependencyError",
+		"/properties/either/anyOf":                 "NumberAnyOfError",
+		"/properties/exclusive/oneOf":              "NumberOneOfError",
+	} {
+		assert.Equal(t, errorType, errorTypes[location], location)
+	}
+}
+
+func TestGenerateInvalidSkipped(t *testing.T) {
+
+	// any string shorter than 2 fails both keywords
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {"code": {"type": "string", "minLength": 2, "pattern": "^..+$", "maxLength": 4}},
+		"required": ["code"]
+	}`))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	invalid, skipped, err := NewInstanceGenerator(1).GenerateInvalid(schema)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	var locations []string
+	for _, i := range invalid {
+		locations = append(locations, i.Location)
+	}
+	assert.ElementsMatch(t, []string{"/required", "/properties/code/type", "/properties/code/maxLength"}, locations)
+	assert.Equal(t, []SkippedKeyword{
+		{Location: "/properties/code/minLength", Keyword: KEY_MIN_LENGTH, InstanceLocation: "/code"},
+		{Location: "/properties/code/pattern", Keyword: KEY_PATTERN, InstanceLocation: "/code"},
+	}, skipped)
+}
@@ -24,22 +24,28 @@
 
 package gojsonschema
 
-import "bytes"
+import (
+	"bytes"
+	"strings"
+)
 
-// jsonContext implements a persistent linked-list of strings
-type jsonContext struct {
+// JsonContext implements a persistent linked-list of strings, it locates
+// the validated value from the root of the document, ie (root).a.0
+type JsonContext struct {
 	head string
-	tail *jsonContext
+	tail *JsonContext
 }
 
-func newJsonContext(head string, tail *jsonContext) *jsonContext {
-	return &jsonContext{head, tail}
+// NewJsonContext returns the context of the child head of the value located by tail,
+// tail being nil for the root of the document
+func NewJsonContext(head string, tail *JsonContext) *JsonContext {
+	return &JsonContext{head, tail}
 }
 
 // String displays the context in reverse.
 // This plays well with the data structure's persistent nature with
 // Cons and a json document's tree structure.
-func (c *jsonContext) String(del ...string) string {
+func (c *JsonContext) String(del ...string) string {
 	byteArr := make([]byte, 0, c.stringLen())
 	buf := bytes.NewBuffer(byteArr)
 	c.writeStringToBuffer(buf, del)
@@ -47,7 +53,7 @@ func (c *jsonContext) String(del ...string) string {
 	return buf.String()
 }
 
-func (c *jsonContext) stringLen() int {
+func (c *JsonContext) stringLen() int {
 	length := 0
 	if c.tail != nil {
 		length = c.tail.stringLen() + 1 // add 1 for "."
@@ -57,7 +63,7 @@ func (c *jsonContext) stringLen() int {
 	return length
 }
 
-func (c *jsonContext) writeStringToBuffer(buf *bytes.Buffer, del []string) {
+func (c *JsonContext) writeStringToBuffer(buf *bytes.Buffer, del []string) {
 	if c.tail != nil {
 		c.tail.writeStringToBuffer(buf, del)
 
@@ -70,3 +76,34 @@ func (c *jsonContext) writeStringToBuffer(buf *bytes.Buffer, del []string) {
 
 	buf.WriteString(c.head)
 }
+
+// JsonPointer returns the context as a JSON Pointer ( RFC 6901 ), ie /a/b/0
+// The root of the document is the empty string.
+func (c *JsonContext) JsonPointer() string {
+
+	if c.tail == nil {
+		return ""
+	}
+
+	token := strings.Replace(c.head, "~", "~0", -1)
+	token = strings.Replace(token, "/", "~1", -1)
+
+	return c.tail.JsonPointer() + "/" + token
+}
+
+// jsonContextOf returns the context of a JSON Pointer, its tokens being unescaped
+func jsonContextOf(pointer string) *JsonContext {
+
+	context := NewJsonContext(STRING_CONTEXT_ROOT, nil)
+	if pointer == "" {
+		return context
+	}
+
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		context = NewJsonContext(jsonPointerTokenUnescaper.Replace(token), context)
+	}
+
+	return context
+}
+
+var jsonPointerTokenUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
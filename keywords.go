@@ -0,0 +1,196 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Known keywords, and checks of a schema document locating the errors
+//                  exactly ( unknown keywords in strict mode, regular expressions ).
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// STRING_EXTENSION_PREFIX prefixes vendor extension keywords, always accepted in strict mode
+const STRING_EXTENSION_PREFIX = "x-"
+
+// draft04Keywords holds every keyword of draft-04, including the ones that
+// are accepted but have no effect on validation, and the annotations of
+// later drafts that are collected
+var draft04Keywords = map[string]bool{
+	"$schema":                 true,
+	"id":                      true,
+	"default":                 true,
+	KEY_SCHEMA:                true,
+	KEY_ID:                    true,
+	KEY_REF:                   true,
+	KEY_TITLE:                 true,
+	KEY_DESCRIPTION:           true,
+	KEY_TYPE:                  true,
+	KEY_ITEMS:                 true,
+	KEY_ADDITIONAL_ITEMS:      true,
+	KEY_PROPERTIES:            true,
+	KEY_PATTERN_PROPERTIES:    true,
+	KEY_ADDITIONAL_PROPERTIES: true,
+	KEY_DEFINITIONS:           true,
+	KEY_MULTIPLE_OF:           true,
+	KEY_MINIMUM:               true,
+	KEY_MAXIMUM:               true,
+	KEY_EXCLUSIVE_MINIMUM:     true,
+	KEY_EXCLUSIVE_MAXIMUM:     true,
+	KEY_MIN_LENGTH:            true,
+	KEY_MAX_LENGTH:            true,
+	KEY_PATTERN:               true,
+	KEY_FORMAT:                true,
+	KEY_MIN_PROPERTIES:        true,
+	KEY_MAX_PROPERTIES:        true,
+	KEY_DEPENDENCIES:          true,
+	KEY_REQUIRED:              true,
+	KEY_MIN_ITEMS:             true,
+	KEY_MAX_ITEMS:             true,
+	KEY_UNIQUE_ITEMS:          true,
+	KEY_ENUM:                  true,
+	KEY_ONE_OF:                true,
+	KEY_ANY_OF:                true,
+	KEY_ALL_OF:                true,
+	KEY_NOT:                   true,
+	KEY_EXAMPLES:              true,
+	KEY_READ_ONLY:             true,
+	KEY_WRITE_ONLY:            true,
+	KEY_DEPRECATED:            true,
+}
+
+// schemaChecker walks a schema document, reporting in strict mode the keywords
+// that are neither draft-04 keywords, extensions nor explicitly allowed, and
+// the regular expressions the engine cannot compile
+type schemaChecker struct {
+	strict   bool
+	allowed  map[string]bool
+	engine   RegexpEngine
+	patterns bool // check pattern, when not done by the meta-schema
+	result   *Result
+}
+
+func newSchemaChecker(sl *SchemaLoader, engine RegexpEngine, allowed []string) *schemaChecker {
+	c := &schemaChecker{
+		strict:   sl.Strict,
+		allowed:  make(map[string]bool),
+		engine:   engine,
+		patterns: !sl.Validate,
+		result:   &Result{},
+	}
+	for _, k := range allowed {
+		c.allowed[k] = true
+	}
+	return c
+}
+
+func (c *schemaChecker) isAllowed(keyword string) bool {
+	return draft04Keywords[keyword] || c.allowed[keyword] || strings.HasPrefix(keyword, STRING_EXTENSION_PREFIX)
+}
+
+// checkSchema checks a schema object, then every subschema found at the
+// locations draft-04 defines as schemas
+func (c *schemaChecker) checkSchema(document interface{}, context *JsonContext) {
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, k := range sortedKeys(m) {
+		v := m[k]
+		switch k {
+		case KEY_PATTERN_PROPERTIES:
+			if patterns, ok := v.(map[string]interface{}); ok {
+				for _, pattern := range sortedKeys(patterns) {
+					c.checkRegexp(pattern, NewJsonContext(pattern, NewJsonContext(k, context)))
+				}
+			}
+			c.checkSchemaMap(v, NewJsonContext(k, context))
+		case KEY_PATTERN:
+			if pattern, ok := v.(string); ok && c.patterns {
+				c.checkRegexp(pattern, NewJsonContext(k, context))
+			}
+		case KEY_PROPERTIES, KEY_DEFINITIONS, KEY_DEPENDENCIES:
+			// property dependencies are arrays, checkSchema skips them
+			c.checkSchemaMap(v, NewJsonContext(k, context))
+		case KEY_ITEMS, KEY_ALL_OF, KEY_ANY_OF, KEY_ONE_OF:
+			if a, ok := v.([]interface{}); ok {
+				for i, item := range a {
+					c.checkSchema(item, NewJsonContext(strconv.Itoa(i), NewJsonContext(k, context)))
+				}
+			} else {
+				c.checkSchema(v, NewJsonContext(k, context))
+			}
+		case KEY_ADDITIONAL_ITEMS, KEY_ADDITIONAL_PROPERTIES, KEY_NOT:
+			c.checkSchema(v, NewJsonContext(k, context))
+		default:
+			if c.strict && !c.isAllowed(k) {
+				c.result.addError(
+					new(UnknownKeywordError),
+					NewJsonContext(k, context),
+					v,
+					ErrorDetails{"keyword": k},
+				)
+			}
+		}
+	}
+}
+
+func (c *schemaChecker) checkSchemaMap(document interface{}, context *JsonContext) {
+	if m, ok := document.(map[string]interface{}); ok {
+		for _, k := range sortedKeys(m) {
+			c.checkSchema(m[k], NewJsonContext(k, context))
+		}
+	}
+}
+
+// sortedKeys returns the keys of m in order, so errors come out the same on every run
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *schemaChecker) checkRegexp(pattern string, context *JsonContext) {
+	if _, err := c.engine.Compile(pattern); err != nil {
+		c.result.addError(
+			new(InvalidRegexError),
+			context,
+			pattern,
+			ErrorDetails{"pattern": pattern, "error": err},
+		)
+	}
+}
+
+// checkSchemaDocument returns the errors found in a schema document found at
+// context, located by the JSON Pointer of the faulty keyword
+func (c *schemaChecker) checkSchemaDocument(document interface{}, context *JsonContext) *Result {
+	c.checkSchema(document, context)
+	return c.result
+}
@@ -0,0 +1,420 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Linting of compiled schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LintSeverity is the severity of a LintIssue
+type LintSeverity string
+
+const (
+	// LINT_ERROR : no value satisfies the schema, or the part of it located
+	LINT_ERROR LintSeverity = "error"
+	// LINT_WARNING : the schema is valid but likely not what was meant
+	LINT_WARNING LintSeverity = "warning"
+	// LINT_INFO : a matter of style
+	LINT_INFO LintSeverity = "info"
+)
+
+// LintIssue is a problem found in a schema
+type LintIssue struct {
+	Severity LintSeverity
+	// Location is the JSON Pointer of the schema, absolute for the schemas of other documents
+	Location    string
+	Keyword     string
+	Description string
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s %s: %s", i.Severity, pointerOrRoot(i.Location), i.Description)
+}
+
+// Lint returns the problems of the schema, in the order of the locations :
+//
+//   - errors : contradictions no value satisfies, including the ones across
+//     allOf found by Simplify, a required property forbidden by
+//     additionalProperties, an enum without value of the allowed types
+//   - warnings : bounds that cannot be both satisfied while values of other
+//     types are valid, oneOf branches that can never match alone, definitions
+//     never referenced, $ref not pointing to a schema location, patterns
+//     matching every string
+//   - infos : patterns not anchored
+//
+// Compiling already fails on $ref that cannot be resolved.
+func (d *Schema) Lint() []LintIssue {
+
+	l := &linter{
+		root:     referenceLocation(d.rootSchema.ref),
+		visited:  map[string]bool{},
+		reported: map[string]bool{},
+	}
+
+	_, conflicts := d.Simplify()
+	for _, conflict := range conflicts {
+		severity := LINT_ERROR
+		if !conflict.Unsatisfiable {
+			// values of other types are still valid
+			severity = LINT_WARNING
+		}
+		l.issues = append(l.issues, LintIssue{
+			Severity:    severity,
+			Location:    conflict.Location,
+			Keyword:     conflict.Keyword,
+			Description: conflict.Description,
+		})
+	}
+
+	l.walk(d.rootSchema, l.root)
+
+	// once the schemas reachable from the root are known
+	for len(l.definitions) > 0 {
+		definition := l.definitions[0]
+		l.definitions = l.definitions[1:]
+		if strings.HasPrefix(definition.location, l.root) && !l.isReferenced(definition.location) {
+			l.add(LINT_WARNING, definition.location, KEY_DEFINITIONS, Locale.UnusedDefinition(), ErrorDetails{"definition": definition.name})
+		}
+		l.walk(definition.s, definition.location)
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Location < l.issues[j].Location
+	})
+
+	return l.issues
+}
+
+type linter struct {
+	// root is the location of the root schema
+	root   string
+	issues []LintIssue
+	// visited holds the locations walked, reported the issues added per schema
+	visited  map[string]bool
+	reported map[string]bool
+	// referenced holds the locations $ref point to
+	referenced  []string
+	definitions []lintedSchema
+}
+
+// lintedSchema is a schema and its location
+type lintedSchema struct {
+	name     string
+	location string
+	s        *subSchema
+}
+
+func (l *linter) add(severity LintSeverity, location string, keyword string, format string, details ErrorDetails) {
+	l.issues = append(l.issues, LintIssue{
+		Severity:    severity,
+		Location:    strings.TrimPrefix(location, l.root),
+		Keyword:     keyword,
+		Description: formatErrorDescription(format, details),
+	})
+}
+
+func (l *linter) isReferenced(location string) bool {
+	for _, reference := range l.referenced {
+		if reference == location || strings.HasPrefix(reference, location+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// walk checks s found at location, then its subschemas
+func (l *linter) walk(s *subSchema, location string) {
+
+	if l.visited[location] {
+		return
+	}
+	l.visited[location] = true
+
+	if s.refSchema != nil {
+		reference := referenceLocation(s.resolvedReference)
+		l.referenced = append(l.referenced, reference)
+		if u := s.resolvedReference.GetUrl(); u != nil && !isSchemaPointer(u.Fragment) {
+			l.add(LINT_WARNING, location, KEY_REF, Locale.ReferenceNotASchema(), ErrorDetails{"reference": s.reference})
+		}
+		l.walk(s.refSchema, reference)
+		return
+	}
+
+	if s.pattern != nil {
+		l.checkPattern(location, KEY_PATTERN, s.pattern)
+	}
+	for _, pattern := range sortedPatterns(s) {
+		l.checkPattern(location, KEY_PATTERN_PROPERTIES, s.patternPropertiesRegexps[pattern])
+	}
+	l.checkEnum(s, location)
+	l.checkRequired(s, location)
+	l.checkOneOf(s, location)
+
+	child := func(child *subSchema, tokens ...string) {
+		childLocation := location
+		for _, token := range tokens {
+			childLocation += "/" + jsonPointerTokenEscaper.Replace(token)
+		}
+		l.walk(child, childLocation)
+	}
+	children := func(keyword string, list []*subSchema) {
+		for i, s := range list {
+			child(s, keyword, strconv.Itoa(i))
+		}
+	}
+
+	for _, name := range sortedDefinitions(s) {
+		l.definitions = append(l.definitions, lintedSchema{
+			name:     name,
+			location: location + "/" + KEY_DEFINITIONS + "/" + jsonPointerTokenEscaper.Replace(name),
+			s:        s.definitions[name],
+		})
+	}
+
+	for _, property := range s.propertiesChildren {
+		child(property, KEY_PROPERTIES, property.property)
+	}
+	for _, pattern := range sortedPatterns(s) {
+		child(s.patternProperties[pattern], KEY_PATTERN_PROPERTIES, pattern)
+	}
+	if additional, ok := s.additionalProperties.(*subSchema); ok {
+		child(additional, KEY_ADDITIONAL_PROPERTIES)
+	}
+	for name, dependency := range s.dependencies {
+		if dependency, ok := dependency.(*subSchema); ok {
+			child(dependency, KEY_DEPENDENCIES, name)
+		}
+	}
+	if s.itemsChildrenIsSingleSchema {
+		child(s.itemsChildren[0], KEY_ITEMS)
+	} else {
+		children(KEY_ITEMS, s.itemsChildren)
+	}
+	if additional, ok := s.additionalItems.(*subSchema); ok {
+		child(additional, KEY_ADDITIONAL_ITEMS)
+	}
+	children(KEY_ALL_OF, s.allOf)
+	children(KEY_ANY_OF, s.anyOf)
+	children(KEY_ONE_OF, s.oneOf)
+	if s.not != nil {
+		child(s.not, KEY_NOT)
+	}
+}
+
+// checkPattern reports the patterns that match every string, ie the empty
+// string and a string of a control character, and the ones not anchored
+func (l *linter) checkPattern(location string, keyword string, r Regexp) {
+
+	pattern := regexpString(r)
+	switch {
+	case r == nil:
+	case r.MatchString("") && r.MatchString("\x00"):
+		l.add(LINT_WARNING, location, keyword, Locale.PatternMatchesEverything(), ErrorDetails{"pattern": pattern})
+	case !strings.HasPrefix(pattern, "^") && !strings.HasSuffix(pattern, "$"):
+		l.add(LINT_INFO, location, keyword, Locale.PatternNotAnchored(), ErrorDetails{"pattern": pattern})
+	}
+}
+
+func (l *linter) checkEnum(s *subSchema, location string) {
+
+	if !s.types.IsTyped() || len(s.enum) == 0 {
+		return
+	}
+	for _, value := range s.enumValues() {
+		if acceptsType(s.types.types, jsonType(value)) {
+			return
+		}
+	}
+	l.add(LINT_ERROR, location, KEY_ENUM, Locale.EnumOutsideType(), ErrorDetails{"type": s.types.String()})
+}
+
+// checkRequired reports the properties required by s, or by its allOf, that
+// additionalProperties false of any of them forbids
+func (l *linter) checkRequired(s *subSchema, location string) {
+
+	members := allOfClosure(s, location, map[*subSchema]bool{})
+
+	var required []string
+	for _, member := range members {
+		required = append(required, member.s.required...)
+	}
+
+	for _, member := range members {
+		if member.s.additionalProperties != false {
+			continue
+		}
+		for _, name := range required {
+			if isPropertyDeclared(member.s, name) {
+				continue
+			}
+			key := member.location + "\x00" + name
+			if l.reported[key] {
+				continue
+			}
+			l.reported[key] = true
+			l.add(LINT_ERROR, member.location, KEY_REQUIRED, Locale.RequiredNotAllowed(), ErrorDetails{"property": name})
+		}
+	}
+}
+
+// checkOneOf reports the branches of oneOf that make a value match more than one
+func (l *linter) checkOneOf(s *subSchema, location string) {
+
+	if len(s.oneOf) < 2 {
+		return
+	}
+
+	documents := make([]map[string]interface{}, len(s.oneOf))
+	for i, branch := range s.oneOf {
+		documents[i] = (&schemaWriter{}).document(resolvedSchema(branch), "")
+	}
+
+	for i, document := range documents {
+		branchLocation := location + "/" + KEY_ONE_OF + "/" + strconv.Itoa(i)
+		if acceptsEverything(document) {
+			l.add(LINT_WARNING, branchLocation, KEY_ONE_OF, Locale.CatchAllBranch(), ErrorDetails{"index": i})
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(document, documents[j]) {
+				l.add(LINT_WARNING, branchLocation, KEY_ONE_OF, Locale.DuplicateBranch(), ErrorDetails{"index": i, "other": j})
+				break
+			}
+		}
+	}
+}
+
+// allOfClosure returns s and the schemas of its allOf, recursively, $ref being resolved
+func allOfClosure(s *subSchema, location string, visited map[*subSchema]bool) []lintedSchema {
+
+	for s.refSchema != nil && !visited[s] {
+		visited[s] = true
+		location = referenceLocation(s.resolvedReference)
+		s = s.refSchema
+	}
+	if visited[s] {
+		return nil
+	}
+	visited[s] = true
+
+	members := []lintedSchema{{location: location, s: s}}
+	for i, branch := range s.allOf {
+		members = append(members, allOfClosure(branch, location+"/"+KEY_ALL_OF+"/"+strconv.Itoa(i), visited)...)
+	}
+	return members
+}
+
+// isPropertyDeclared tells if a property is in properties or matches patternProperties
+func isPropertyDeclared(s *subSchema, name string) bool {
+	if propertiesByName(s)[name] != nil {
+		return true
+	}
+	for _, r := range s.patternPropertiesRegexps {
+		if r.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintAnnotations are the keywords that do not restrict the valid values
+var lintAnnotations = map[string]bool{
+	KEY_SCHEMA:      true,
+	KEY_ID:          true,
+	KEY_TITLE:       true,
+	KEY_DESCRIPTION: true,
+	KEY_DEFINITIONS: true,
+	KEY_EXAMPLES:    true,
+	KEY_READ_ONLY:   true,
+	KEY_WRITE_ONLY:  true,
+	KEY_DEPRECATED:  true,
+}
+
+// acceptsEverything tells if a schema document has only annotations
+func acceptsEverything(document map[string]interface{}) bool {
+	for k := range document {
+		if !lintAnnotations[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// isSchemaPointer tells if the JSON Pointer of a fragment goes through
+// schema locations only, "$defs" of later drafts being accepted
+func isSchemaPointer(fragment string) bool {
+
+	if !strings.HasPrefix(fragment, "/") {
+		// empty, or a plain name fragment
+		return true
+	}
+
+	tokens := strings.Split(fragment[1:], "/")
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case KEY_PROPERTIES, KEY_PATTERN_PROPERTIES, KEY_DEFINITIONS, "$defs", KEY_DEPENDENCIES, KEY_ALL_OF, KEY_ANY_OF, KEY_ONE_OF:
+			// followed by a name or an index
+			i++
+			if i == len(tokens) {
+				return false
+			}
+		case KEY_ITEMS:
+			// a schema or an array of schemas
+			if i+1 < len(tokens) {
+				if _, err := strconv.Atoi(tokens[i+1]); err == nil {
+					i++
+				}
+			}
+		case KEY_ADDITIONAL_PROPERTIES, KEY_ADDITIONAL_ITEMS, KEY_NOT:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedPatterns(s *subSchema) []string {
+	patterns := make([]string, 0, len(s.patternProperties))
+	for pattern := range s.patternProperties {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+func sortedDefinitions(s *subSchema) []string {
+	names := make([]string, 0, len(s.definitions))
+	for name := range s.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,87 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the linting of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+
+	s, err := NewSchema(NewStringLoader(`{
+		"definitions": {
+			"used": {"type": "string", "pattern": "^[a-z]+$"},
+			"unused": {"type": "string"},
+			"nested": {"properties": {"a": {}}}
+		},
+		"properties": {
+			"name": {"$ref": "#/definitions/used"},
+			"level": {"allOf": [{"type": "integer", "minimum": 10}, {"maximum": 5}]},
+			"bounded": {"allOf": [{"minimum": 5}, {"maximum": 3}]},
+			"closed": {
+				"properties": {"a": {}},
+				"patternProperties": {"^x-": {}},
+				"additionalProperties": false,
+				"required": ["a", "x-b"],
+				"allOf": [{"required": ["c"]}]
+			},
+			"kind": {"type": "integer", "enum": ["a", "b"]},
+			"code": {"pattern": "[0-9]*"},
+			"digits": {"pattern": "^[0-9]*$"},
+			"either": {"pattern": "^a|b*$"},
+			"tag": {"pattern": "[a-z]"},
+			"shape": {"oneOf": [{"type": "string"}, {"type": "number"}, {"type": "string"}, {"title": "any"}]},
+			"odd": {"$ref": "#/definitions/nested/properties"}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	var issues []string
+	for _, issue := range s.Lint() {
+		issues = append(issues, issue.String())
+	}
+	assert.Equal(t, []string{
+		"warning /definitions/unused: Definition unused is never referenced",
+		"warning /properties/bounded: minimum and maximum cannot be both satisfied",
+		"error /properties/closed: Required property c is not allowed by additionalProperties",
+		"warning /properties/code: Pattern [0-9]* matches every string",
+		"warning /properties/either: Pattern ^a|b*$ matches every string",
+		"error /properties/kind: No value of enum is of type integer",
+		"error /properties/level: minimum and maximum cannot be both satisfied",
+		"warning /properties/odd: Reference #/definitions/nested/properties does not point to a schema",
+		"warning /properties/shape/oneOf/2: Branch 2 is the same as branch 0, neither can match alone",
+		"warning /properties/shape/oneOf/3: Branch 3 accepts every value, no other branch can match alone",
+		"info /properties/tag: Pattern [a-z] is not anchored, it matches anywhere in the string",
+	}, issues)
+
+	s, err = NewSchema(NewStringLoader(`{"type": "object", "properties": {"a": {"type": "string"}}}`))
+	assert.Nil(t, err)
+	assert.Empty(t, s.Lint())
+}
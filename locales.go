@@ -54,6 +54,9 @@ type (
 		NumberGT() string
 		NumberLTE() string
 		NumberLT() string
+		UnknownKeyword() string
+		ReadOnly() string
+		WriteOnly() string
 
 		// Schema validations
 		RegexPattern() string
@@ -70,8 +73,21 @@ type (
 		KeyItemsMustBeOfType() string
 		KeyItemsMustBeUnique() string
 		ReferenceMustBeCanonical() string
+		RecursiveReference() string
+		EmptyIntersection() string
+		ConflictingKeywords() string
+		RequiredNotAllowed() string
+		EnumOutsideType() string
+		DuplicateBranch() string
+		CatchAllBranch() string
+		UnusedDefinition() string
+		ReferenceNotASchema() string
+		PatternMatchesEverything() string
+		PatternNotAnchored() string
+		NoValidInstance() string
 		NotAValidType() string
 		Duplicated() string
+		InvalidSchema() string
 		httpBadStatus() string
 
 		// ErrorFormat
@@ -186,6 +202,18 @@ func (l DefaultLocale) NumberLT() string {
 	return `Must be less than %max%`
 }
 
+func (l DefaultLocale) UnknownKeyword() string {
+	return `Unknown keyword %keyword%`
+}
+
+func (l DefaultLocale) ReadOnly() string {
+	return `%property% is read only and not allowed in a request`
+}
+
+func (l DefaultLocale) WriteOnly() string {
+	return `%property% is write only and not allowed in a response`
+}
+
 // Schema validators
 func (l DefaultLocale) RegexPattern() string {
 	return `Invalid regex pattern '%pattern%'`
@@ -243,6 +271,54 @@ func (l DefaultLocale) ReferenceMustBeCanonical() string {
 	return `Reference %reference% must be canonical`
 }
 
+func (l DefaultLocale) RecursiveReference() string {
+	return `Reference %reference% is recursive`
+}
+
+func (l DefaultLocale) EmptyIntersection() string {
+	return `No value is allowed by every %keyword%`
+}
+
+func (l DefaultLocale) ConflictingKeywords() string {
+	return `%keyword% and %other% cannot be both satisfied`
+}
+
+func (l DefaultLocale) RequiredNotAllowed() string {
+	return `Required property %property% is not allowed by additionalProperties`
+}
+
+func (l DefaultLocale) EnumOutsideType() string {
+	return `No value of enum is of type %type%`
+}
+
+func (l DefaultLocale) DuplicateBranch() string {
+	return `Branch %index% is the same as branch %other%, neither can match alone`
+}
+
+func (l DefaultLocale) CatchAllBranch() string {
+	return `Branch %index% accepts every value, no other branch can match alone`
+}
+
+func (l DefaultLocale) UnusedDefinition() string {
+	return `Definition %definition% is never referenced`
+}
+
+func (l DefaultLocale) ReferenceNotASchema() string {
+	return `Reference %reference% does not point to a schema`
+}
+
+func (l DefaultLocale) PatternMatchesEverything() string {
+	return `Pattern %pattern% matches every string`
+}
+
+func (l DefaultLocale) PatternNotAnchored() string {
+	return `Pattern %pattern% is not anchored, it matches anywhere in the string`
+}
+
+func (l DefaultLocale) NoValidInstance() string {
+	return `No valid instance generated in %attempts% attempts, last error : %error%`
+}
+
 func (l DefaultLocale) NotAValidType() string {
 	return `%type% is not a valid type -- `
 }
@@ -251,6 +327,10 @@ func (l DefaultLocale) Duplicated() string {
 	return `%type% type is duplicated`
 }
 
+func (l DefaultLocale) InvalidSchema() string {
+	return `Invalid schema: %errors%`
+}
+
 func (l DefaultLocale) httpBadStatus() string {
 	return `Could not read schema from HTTP, response status is %status%`
 }
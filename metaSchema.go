@@ -0,0 +1,320 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Embedded draft-04 meta-schema.
+//                  Used to validate the schema documents before compiling them.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+const draft04MetaSchemaUrl = "http://json-schema.org/draft-04/schema"
+
+// http://json-schema.org/draft-04/schema
+const draft04MetaSchemaSource = `{
+    "id": "http://json-schema.org/draft-04/schema#",
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "description": "Core schema meta-schema",
+    "definitions": {
+        "schemaArray": {
+            "type": "array",
+            "minItems": 1,
+            "items": {
+                "$ref": "#"
+            }
+        },
+        "positiveInteger": {
+            "type": "integer",
+            "minimum": 0
+        },
+        "positiveIntegerDefault0": {
+            "allOf": [
+                {
+                    "$ref": "#/definitions/positiveInteger"
+                },
+                {
+                    "default": 0
+                }
+            ]
+        },
+        "simpleTypes": {
+            "enum": [
+                "array",
+                "boolean",
+                "integer",
+                "null",
+                "number",
+                "object",
+                "string"
+            ]
+        },
+        "stringArray": {
+            "type": "array",
+            "items": {
+                "type": "string"
+            },
+            "minItems": 1,
+            "uniqueItems": true
+        }
+    },
+    "type": "object",
+    "properties": {
+        "id": {
+            "type": "string"
+        },
+        "$schema": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "description": {
+            "type": "string"
+        },
+        "default": {},
+        "multipleOf": {
+            "type": "number",
+            "minimum": 0,
+            "exclusiveMinimum": true
+        },
+        "maximum": {
+            "type": "number"
+        },
+        "exclusiveMaximum": {
+            "type": "boolean",
+            "default": false
+        },
+        "minimum": {
+            "type": "number"
+        },
+        "exclusiveMinimum": {
+            "type": "boolean",
+            "default": false
+        },
+        "maxLength": {
+            "$ref": "#/definitions/positiveInteger"
+        },
+        "minLength": {
+            "$ref": "#/definitions/positiveIntegerDefault0"
+        },
+        "pattern": {
+            "type": "string",
+            "format": "regex"
+        },
+        "additionalItems": {
+            "anyOf": [
+                {
+                    "type": "boolean"
+                },
+                {
+                    "$ref": "#"
+                }
+            ],
+            "default": {}
+        },
+        "items": {
+            "anyOf": [
+                {
+                    "$ref": "#"
+                },
+                {
+                    "$ref": "#/definitions/schemaArray"
+                }
+            ],
+            "default": {}
+        },
+        "maxItems": {
+            "$ref": "#/definitions/positiveInteger"
+        },
+        "minItems": {
+            "$ref": "#/definitions/positiveIntegerDefault0"
+        },
+        "uniqueItems": {
+            "type": "boolean",
+            "default": false
+        },
+        "maxProperties": {
+            "$ref": "#/definitions/positiveInteger"
+        },
+        "minProperties": {
+            "$ref": "#/definitions/positiveIntegerDefault0"
+        },
+        "required": {
+            "$ref": "#/definitions/stringArray"
+        },
+        "additionalProperties": {
+            "anyOf": [
+                {
+                    "type": "boolean"
+                },
+                {
+                    "$ref": "#"
+                }
+            ],
+            "default": {}
+        },
+        "definitions": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#"
+            },
+            "default": {}
+        },
+        "properties": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#"
+            },
+            "default": {}
+        },
+        "patternProperties": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#"
+            },
+            "default": {}
+        },
+        "dependencies": {
+            "type": "object",
+            "additionalProperties": {
+                "anyOf": [
+                    {
+                        "$ref": "#"
+                    },
+                    {
+                        "$ref": "#/definitions/stringArray"
+                    }
+                ]
+            }
+        },
+        "enum": {
+            "type": "array",
+            "minItems": 1,
+            "uniqueItems": true
+        },
+        "type": {
+            "anyOf": [
+                {
+                    "$ref": "#/definitions/simpleTypes"
+                },
+                {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/simpleTypes"
+                    },
+                    "minItems": 1,
+                    "uniqueItems": true
+                }
+            ]
+        },
+        "format": {
+            "type": "string"
+        },
+        "allOf": {
+            "$ref": "#/definitions/schemaArray"
+        },
+        "anyOf": {
+            "$ref": "#/definitions/schemaArray"
+        },
+        "oneOf": {
+            "$ref": "#/definitions/schemaArray"
+        },
+        "not": {
+            "$ref": "#"
+        }
+    },
+    "dependencies": {
+        "exclusiveMaximum": [
+            "maximum"
+        ],
+        "exclusiveMinimum": [
+            "minimum"
+        ]
+    },
+    "default": {}
+}`
+
+var (
+	draft04MetaSchemaOnce sync.Once
+	draft04MetaSchema     *Schema
+	draft04MetaSchemaErr  error
+)
+
+// InvalidSchemaError is returned when compiling a schema that does not validate
+// against the draft-04 meta-schema, that has invalid regular expressions or that
+// uses unknown keywords in strict mode.
+// The context of each error of the Result locates the faulty part of the schema,
+// use Context().JsonPointer() to get it as a JSON Pointer. Documents loaded by
+// a $ref are checked as well, Document() returns the url of the faulty one.
+type InvalidSchemaError struct {
+	result *Result
+	// document is the url of the faulty document, "" for the root document
+	document string
+}
+
+// Document returns the url of the document holding the errors, "" for the root document
+func (e *InvalidSchemaError) Document() string {
+	return e.document
+}
+
+// Result returns the errors found while validating the schema
+func (e *InvalidSchemaError) Result() *Result {
+	return e.result
+}
+
+func (e *InvalidSchemaError) Error() string {
+
+	messages := make([]string, 0, len(e.result.Errors()))
+	for _, err := range e.result.Errors() {
+		messages = append(messages, e.document+"#"+err.Context().JsonPointer()+": "+err.Description())
+	}
+
+	return formatErrorDescription(
+		Locale.InvalidSchema(),
+		ErrorDetails{"errors": strings.Join(messages, ", ")},
+	)
+}
+
+// validateMetaSchema validates a schema document found at location against the
+// draft-04 meta-schema, the regex format being checked with the engine of the schema
+func validateMetaSchema(document interface{}, location *JsonContext, engine RegexpEngine) (*Result, error) {
+
+	draft04MetaSchemaOnce.Do(func() {
+		sl := NewSchemaLoader()
+		sl.Validate = false
+		draft04MetaSchema, draft04MetaSchemaErr = sl.Compile(NewStringLoader(draft04MetaSchemaSource))
+	})
+
+	if draft04MetaSchemaErr != nil {
+		return nil, draft04MetaSchemaErr
+	}
+
+	state := draft04MetaSchema.newValidationState(context.Background(), document)
+	state.regexpEngine = engine
+
+	return draft04MetaSchema.rootSchema.subValidateWithContext(document, location, state), nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the validation of schemas against the meta-schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaSchemaValidation(t *testing.T) {
+
+	_, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"a": {"type": "strnig"},
+			"b": {"items": [{"minLength": -1}]}
+		},
+		"required": []
+	}`))
+
+	invalidSchemaError, ok := err.(*InvalidSchemaError)
+	if !assert.True(t, ok, "expected an InvalidSchemaError, given %v", err) {
+		return
+	}
+
+	pointers := map[string]bool{}
+	for _, e := range invalidSchemaError.Result().Errors() {
+		pointers[e.Context().JsonPointer()] = true
+	}
+
+	assert.True(t, pointers["/properties/a/type"])
+	assert.True(t, pointers["/properties/b/items/0/minLength"])
+	assert.True(t, pointers["/required"])
+	assert.Contains(t, err.Error(), "#/properties/a/type: ")
+
+	sl := NewSchemaLoader()
+	sl.Validate = false
+	_, err = sl.Compile(NewStringLoader(`{"required": []}`))
+	assert.Nil(t, err)
+
+	_, err = NewSchema(NewStringLoader(`{"type": "object", "required": ["a"]}`))
+	assert.Nil(t, err)
+}
+
+func TestMetaSchemaValidationOfReferences(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "schema.json"), []byte(`{"properties": {"a": {"$ref": "definitions.json#/a"}}}`), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "definitions.json"), []byte(`{"a": {"type": "integer", "minLength": -1}, "b": "not a schema"}`), 0644)
+
+	_, err = NewSchema(NewReferenceLoader("file://" + filepath.ToSlash(filepath.Join(dir, "schema.json"))))
+
+	invalidSchemaError, ok := err.(*InvalidSchemaError)
+	if !assert.True(t, ok, "expected an InvalidSchemaError, given %v", err) {
+		return
+	}
+	assert.Equal(t, "file://"+filepath.ToSlash(filepath.Join(dir, "definitions.json")), invalidSchemaError.Document())
+	assert.Contains(t, err.Error(), "definitions.json#/a/minLength: ")
+}
+
+func TestJsonContextJsonPointer(t *testing.T) {
+
+	root := NewJsonContext(STRING_CONTEXT_ROOT, nil)
+	assert.Equal(t, "", root.JsonPointer())
+
+	context := NewJsonContext("0", NewJsonContext("a/b~c", root))
+	assert.Equal(t, "/a~1b~0c/0", context.JsonPointer())
+}
+
+func TestStrictMode(t *testing.T) {
+
+	source := `{
+		"properties": {
+			"a": {"type": "string", "requried": true},
+			"b": {"items": [{"x-vendor": 1}, {"maxLenght": 2}]}
+		},
+		"definitions": {"c": {"example": "c"}},
+		"dependencies": {"a": ["b"]},
+		"not": {"foo": {}}
+	}`
+
+	_, err := NewSchema(NewStringLoader(source))
+	assert.Nil(t, err)
+
+	sl := NewSchemaLoader()
+	sl.Strict = true
+	_, err = sl.Compile(NewStringLoader(source))
+
+	invalidSchemaError, ok := err.(*InvalidSchemaError)
+	if !assert.True(t, ok, "expected an InvalidSchemaError, given %v", err) {
+		return
+	}
+
+	pointers := []string{}
+	for _, e := range invalidSchemaError.Result().Errors() {
+		assert.Equal(t, "unknown_keyword", e.Type())
+		pointers = append(pointers, e.Context().JsonPointer())
+	}
+	assert.Equal(t, []string{"/definitions/c/example", "/not/foo", "/properties/a/requried", "/properties/b/items/1/maxLenght"}, pointers)
+
+	sl.AllowedKeywords = []string{"example", "foo", "requried", "maxLenght"}
+	_, err = sl.Compile(NewStringLoader(source))
+	assert.Nil(t, err)
+}
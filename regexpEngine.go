@@ -0,0 +1,167 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Regular expression engines of pattern and patternProperties.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/dlclark/regexp2"
+)
+
+type (
+	// Regexp is a compiled regular expression
+	Regexp interface {
+		MatchString(s string) bool
+		String() string
+	}
+
+	// RegexpEngine compiles the regular expressions of pattern, patternProperties
+	// and of the regex format
+	RegexpEngine interface {
+		Compile(expr string) (Regexp, error)
+	}
+
+	// GoRegexpEngine uses the regexp package ( RE2 syntax ), it is the default engine
+	GoRegexpEngine struct{}
+
+	// ECMARegexpEngine follows ECMA-262 as JSON Schema recommends : lookarounds,
+	// backreferences and ASCII only \d, \w and \s are supported.
+	// It uses github.com/dlclark/regexp2 in ECMAScript mode.
+	ECMARegexpEngine struct {
+		// MatchTimeout bounds the time a match takes, as ECMA-262 patterns
+		// backtrack, 100ms when 0. A match timing out is not a match.
+		MatchTimeout time.Duration
+	}
+
+	ecmaRegexp struct {
+		*regexp2.Regexp
+	}
+)
+
+// documentRegexpCompiler is implemented by the engines caching the expressions
+// of the schemas, the values of the validated documents are compiled without
+// being cached
+type documentRegexpCompiler interface {
+	compileDocumentRegexp(expr string) (Regexp, error)
+}
+
+// ecmaRegexpKey identifies a compiled ECMA-262 expression, the timeout being
+// set on the compiled expression
+type ecmaRegexpKey struct {
+	expr    string
+	timeout time.Duration
+}
+
+var (
+	regexpCacheMutex sync.Mutex
+	ecmaRegexpCache  = map[ecmaRegexpKey]*regexp2.Regexp{}
+)
+
+func (e GoRegexpEngine) Compile(expr string) (Regexp, error) {
+	re, err := regexpCompile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+func (e GoRegexpEngine) compileDocumentRegexp(expr string) (Regexp, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return re, nil
+}
+
+func (e ECMARegexpEngine) Compile(expr string) (Regexp, error) {
+
+	key := ecmaRegexpKey{expr: expr, timeout: e.matchTimeout()}
+
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+
+	if re, ok := ecmaRegexpCache[key]; ok {
+		return ecmaRegexp{re}, nil
+	}
+
+	re, err := e.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	ecmaRegexpCache[key] = re
+	return ecmaRegexp{re}, nil
+}
+
+func (e ECMARegexpEngine) compileDocumentRegexp(expr string) (Regexp, error) {
+	re, err := e.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return ecmaRegexp{re}, nil
+}
+
+func (e ECMARegexpEngine) compile(expr string) (*regexp2.Regexp, error) {
+	re, err := regexp2.Compile(expr, regexp2.ECMAScript)
+	if err != nil {
+		return nil, err
+	}
+	re.MatchTimeout = e.matchTimeout()
+	return re, nil
+}
+
+func (e ECMARegexpEngine) matchTimeout() time.Duration {
+	if e.MatchTimeout == 0 {
+		return 100 * time.Millisecond
+	}
+	return e.MatchTimeout
+}
+
+// MatchString reports whether s contains a match, a match timing out is not a match
+func (r ecmaRegexp) MatchString(s string) bool {
+	matched, err := r.Regexp.MatchString(s)
+	return err == nil && matched
+}
+
+func regexpCompile(key string) (*regexp.Regexp, error) {
+
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+
+	if re, ok := regexpCache[key]; ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(key)
+	if err != nil {
+		return nil, err
+	}
+
+	regexpCache[key] = re
+	return re, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for regular expression engines.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestECMARegexpEngine(t *testing.T) {
+
+	source := `{
+		"properties": {
+			"password": {"type": "string", "pattern": "^(?=.*[0-9])(?=.*[a-z]).{8,}$"},
+			"pair": {"type": "string", "pattern": "^(a|b)\\1$"},
+			"digits": {"type": "string", "pattern": "^\\d+$"}
+		},
+		"patternProperties": {"^x-(?!internal)": {"type": "integer"}}
+	}`
+
+	_, err := NewSchema(NewStringLoader(source))
+	assert.NotNil(t, err, "RE2 has no lookahead")
+
+	sl := NewSchemaLoader()
+	sl.RegexpEngine = ECMARegexpEngine{}
+	schema, err := sl.Compile(NewStringLoader(source))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(NewStringLoader(`{"password": "abcdefg1", "pair": "aa", "digits": "123", "x-a": 1, "x-internal": "s"}`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid(), "%v", result.Errors())
+
+	result, err = schema.Validate(NewStringLoader(`{"password": "abcdefgh", "pair": "ab", "digits": "١٢٣", "x-a": "s"}`))
+	assert.Nil(t, err)
+	pointers := map[string]bool{}
+	for _, e := range result.Errors() {
+		pointers[e.Context().JsonPointer()] = true
+	}
+	// the root gets an invalid_property_pattern error for x-a
+	assert.Equal(t, map[string]bool{"": true, "/password": true, "/pair": true, "/digits": true, "/x-a": true}, pointers)
+}
+
+func TestInvalidRegexLocation(t *testing.T) {
+
+	source := `{
+		"properties": {"a": {"pattern": "(?<=a"}},
+		"patternProperties": {"[a-": {}}
+	}`
+
+	for _, validate := range []bool{true, false} {
+		sl := NewSchemaLoader()
+		sl.Validate = validate
+		_, err := sl.Compile(NewStringLoader(source))
+
+		invalidSchemaError, ok := err.(*InvalidSchemaError)
+		if !assert.True(t, ok, "expected an InvalidSchemaError, given %v", err) {
+			continue
+		}
+
+		pointers := map[string]bool{}
+		for _, e := range invalidSchemaError.Result().Errors() {
+			pointers[e.Context().JsonPointer()] = true
+		}
+		assert.Equal(t, map[string]bool{"/properties/a/pattern": true, "/patternProperties/[a-": true}, pointers)
+	}
+}
+
+func TestRegexFormatIsNotCached(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{"format": "regex"}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(NewStringLoader(`"^document-value-[0-9]+$"`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+	assert.True(t, FormatCheckers.IsFormat("regex", "^is-format-value$"))
+
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+	assert.NotContains(t, regexpCache, "^document-value-[0-9]+$")
+	assert.NotContains(t, regexpCache, "^is-format-value$")
+}
+
+func TestECMARegexpEngineTimeout(t *testing.T) {
+
+	engine := ECMARegexpEngine{MatchTimeout: 10 * time.Millisecond}
+	re, err := engine.Compile(`^(a+)+$`)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	start := time.Now()
+	assert.False(t, re.MatchString(strings.Repeat("a", 40)+"b"))
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	_, err = engine.compileDocumentRegexp("^ecma-document-value$")
+	assert.Nil(t, err)
+
+	regexpCacheMutex.Lock()
+	defer regexpCacheMutex.Unlock()
+	assert.NotContains(t, ecmaRegexpCache, ecmaRegexpKey{expr: "^ecma-document-value$", timeout: 10 * time.Millisecond})
+	assert.Contains(t, ecmaRegexpCache, ecmaRegexpKey{expr: `^(a+)+$`, timeout: 10 * time.Millisecond})
+}
@@ -40,8 +40,8 @@ type (
 		Field() string
 		SetType(string)
 		Type() string
-		SetContext(*jsonContext)
-		Context() *jsonContext
+		SetContext(*JsonContext)
+		Context() *JsonContext
 		SetDescription(string)
 		Description() string
 		SetValue(interface{})
@@ -55,17 +55,30 @@ type (
 	// can be defined by just embedding this type
 	ResultErrorFields struct {
 		errorType   string       // A string with the type of error (i.e. invalid_type)
-		context     *jsonContext // Tree like notation of the part that failed the validation. ex (root).a.b ...
+		context     *JsonContext // Tree like notation of the part that failed the validation. ex (root).a.b ...
 		description string       // A human readable error message
 		value       interface{}  // Value given by the JSON file that is the source of the error
 		details     ErrorDetails
 	}
 
+	// Annotation is a value attached to an instance by a keyword of a valid schema:
+	// title, description, examples, readOnly, writeOnly, deprecated, and format
+	// when formats are not asserted
+	Annotation struct {
+		// Location is the JSON Pointer of the annotated instance
+		Location string
+		Keyword  string
+		Value    interface{}
+	}
+
 	Result struct {
-		errors []ResultError
+		errors      []ResultError
+		annotations []Annotation
 		// Scores how well the validation matched. Useful in generating
 		// better error messages for anyOf and oneOf.
 		score int
+		// shared by the results of a validation
+		state *validationState
 	}
 )
 
@@ -89,11 +102,11 @@ func (v *ResultErrorFields) Type() string {
 	return v.errorType
 }
 
-func (v *ResultErrorFields) SetContext(context *jsonContext) {
+func (v *ResultErrorFields) SetContext(context *JsonContext) {
 	v.context = context
 }
 
-func (v *ResultErrorFields) Context() *jsonContext {
+func (v *ResultErrorFields) Context() *JsonContext {
 	return v.context
 }
 
@@ -154,18 +167,52 @@ func (v *Result) Errors() []ResultError {
 	return v.errors
 }
 
-func (v *Result) addError(err ResultError, context *jsonContext, value interface{}, details ErrorDetails) {
+func (v *Result) addError(err ResultError, context *JsonContext, value interface{}, details ErrorDetails) {
 	newError(err, context, value, Locale, details)
 	v.errors = append(v.errors, err)
 	v.score -= 2 // results in a net -1 when added to the +1 we get at the end of the validation function
 }
 
+// AddError adds an error to the result, it is meant for custom keywords.
+// Errors of types unknown to the library keep the type set with SetType, and
+// the description set with SetDescription is used as a template for details,
+// ie "%field% must be unique by %key%".
+func (v *Result) AddError(err ResultError, context *JsonContext, value interface{}, details ErrorDetails) {
+	if details == nil {
+		details = ErrorDetails{}
+	}
+	v.addError(err, context, value, details)
+}
+
 // Used to copy errors from a sub-schema to the main one
 func (v *Result) mergeErrors(otherResult *Result) {
 	v.errors = append(v.errors, otherResult.Errors()...)
+	v.annotations = append(v.annotations, otherResult.annotations...)
 	v.score += otherResult.score
 }
 
+// Annotations returns the annotations of the valid schemas, by JSON Pointer
+// of the annotated instance, in the order they were collected
+func (v *Result) Annotations() map[string][]Annotation {
+	annotations := make(map[string][]Annotation)
+	for _, a := range v.annotations {
+		annotations[a.Location] = append(annotations[a.Location], a)
+	}
+	return annotations
+}
+
+func (v *Result) addAnnotation(context *JsonContext, keyword string, value interface{}) {
+	if v.state == nil || !v.state.annotations {
+		return
+	}
+	v.annotations = append(v.annotations, Annotation{Location: context.JsonPointer(), Keyword: keyword, Value: value})
+}
+
+// Used to copy the annotations of a valid sub-schema whose errors are not merged
+func (v *Result) mergeAnnotations(otherResult *Result) {
+	v.annotations = append(v.annotations, otherResult.annotations...)
+}
+
 func (v *Result) incrementScore() {
 	v.score++
 }
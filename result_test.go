@@ -0,0 +1,168 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for annotations of the result.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotations(t *testing.T) {
+
+	sl := NewSchemaLoader()
+	sl.Annotations = true
+	schema, err := sl.Compile(NewStringLoader(`{
+		"title": "User",
+		"properties": {
+			"id": {"type": "integer", "readOnly": true},
+			"password": {"$ref": "#/definitions/password"},
+			"contact": {"oneOf": [
+				{"type": "string", "title": "Email", "format": "email"},
+				{"type": "string", "title": "Phone", "pattern": "^[0-9]+$"}
+			]},
+			"nickname": {"type": "string", "description": "Unused", "deprecated": true, "examples": ["bob"]},
+			"code": {"anyOf": [
+				{"type": "string", "title": "Code"},
+				{"type": "integer", "title": "Number"},
+				{"minLength": 2, "description": "Two characters at least"}
+			]}
+		},
+		"definitions": {
+			"password": {"type": "string", "writeOnly": true, "minLength": 8}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(NewStringLoader(`{"id": 1, "password": "secret!!", "contact": "0123", "nickname": "bob", "code": "ab"}`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+	assert.Equal(t, map[string][]Annotation{
+		"":          {{Location: "", Keyword: KEY_TITLE, Value: "User"}},
+		"/id":       {{Location: "/id", Keyword: KEY_READ_ONLY, Value: true}},
+		"/password": {{Location: "/password", Keyword: KEY_WRITE_ONLY, Value: true}},
+		"/contact":  {{Location: "/contact", Keyword: KEY_TITLE, Value: "Phone"}},
+		"/nickname": {
+			{Location: "/nickname", Keyword: KEY_DESCRIPTION, Value: "Unused"},
+			{Location: "/nickname", Keyword: KEY_EXAMPLES, Value: []interface{}{"bob"}},
+			{Location: "/nickname", Keyword: KEY_DEPRECATED, Value: true},
+		},
+		// every valid branch of anyOf annotates
+		"/code": {
+			{Location: "/code", Keyword: KEY_TITLE, Value: "Code"},
+			{Location: "/code", Keyword: KEY_DESCRIPTION, Value: "Two characters at least"},
+		},
+	}, result.Annotations())
+
+	// annotations of failing schemas are dropped
+	result, err = schema.Validate(NewStringLoader(`{"id": 1, "password": "short"}`))
+	assert.Nil(t, err)
+	assert.False(t, result.Valid())
+	assert.Empty(t, result.Annotations())
+
+	// annotations are only collected on demand
+	schema, err = NewSchema(NewStringLoader(`{"title": "User"}`))
+	if assert.Nil(t, err) {
+		result, err = schema.Validate(NewStringLoader(`{}`))
+		assert.Nil(t, err)
+		assert.Empty(t, result.Annotations())
+	}
+
+	_, err = NewSchema(NewStringLoader(`{"readOnly": "yes"}`))
+	assert.NotNil(t, err)
+}
+
+func TestValidateDirection(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"id": {"type": "integer", "readOnly": true},
+			"password": {"$ref": "#/definitions/password"},
+			"name": {"type": "string"}
+		},
+		"definitions": {"password": {"type": "string", "writeOnly": true}}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document := NewStringLoader(`{"id": 1, "password": "secret", "name": "a"}`)
+	ctx := context.Background()
+
+	result, err := schema.ValidateContext(ctx, document)
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+
+	result, err = schema.ValidateDirection(ctx, document, DIRECTION_REQUEST)
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.IsType(t, &ReadOnlyError{}, result.Errors()[0])
+		assert.Equal(t, "id", result.Errors()[0].Field())
+		assert.Equal(t, "(root).id", result.Errors()[0].Context().String())
+	}
+
+	result, err = schema.ValidateDirection(ctx, document, DIRECTION_RESPONSE)
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "write_only", result.Errors()[0].Type())
+		assert.Equal(t, "password is write only and not allowed in a response", result.Errors()[0].Description())
+	}
+}
+
+func TestValidateDirectionPatternAndAdditionalProperties(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"meta": {
+				"patternProperties": {"^x-": {"readOnly": true}},
+				"additionalProperties": {"writeOnly": true}
+			}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document := NewStringLoader(`{"meta": {"x-created": 1, "secret": 2}}`)
+	ctx := context.Background()
+
+	result, err := schema.ValidateDirection(ctx, document, DIRECTION_REQUEST)
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "read_only", result.Errors()[0].Type())
+		assert.Equal(t, "(root).meta.x-created", result.Errors()[0].Context().String())
+	}
+
+	result, err = schema.ValidateDirection(ctx, document, DIRECTION_RESPONSE)
+	assert.Nil(t, err)
+	if assert.Len(t, result.Errors(), 1) {
+		assert.Equal(t, "write_only", result.Errors()[0].Type())
+		assert.Equal(t, "(root).meta.secret", result.Errors()[0].Context().String())
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      RFC 3339 section 5.6 grammar of dates and times.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+// isRFC3339DateTime checks date-time = full-date "T" full-time
+// T and Z may be lowercase ( RFC3339 5.6 NOTE )
+func isRFC3339DateTime(s string) bool {
+
+	if len(s) < 11 || (s[10] != 'T' && s[10] != 't') {
+		return false
+	}
+
+	return isRFC3339FullDate(s[:10]) && isRFC3339FullTime(s[11:])
+}
+
+// isRFC3339FullDate checks full-date = date-fullyear "-" date-month "-" date-mday
+func isRFC3339FullDate(s string) bool {
+
+	if len(s) != 10 || s[4] != '-' || s[7] != '-' {
+		return false
+	}
+
+	year, okYear := rfc3339Number(s[0:4])
+	month, okMonth := rfc3339Number(s[5:7])
+	day, okDay := rfc3339Number(s[8:10])
+	if !okYear || !okMonth || !okDay {
+		return false
+	}
+
+	return month >= 1 && month <= 12 && day >= 1 && day <= rfc3339DaysIn(month, year)
+}
+
+// isRFC3339FullTime checks full-time = partial-time time-offset
+// where partial-time = time-hour ":" time-minute ":" time-second [time-secfrac]
+// A leap second ( second 60 ) is only valid at 23:59 UTC.
+func isRFC3339FullTime(s string) bool {
+
+	if len(s) < 9 || s[2] != ':' || s[5] != ':' {
+		return false
+	}
+
+	hour, okHour := rfc3339Number(s[0:2])
+	minute, okMinute := rfc3339Number(s[3:5])
+	second, okSecond := rfc3339Number(s[6:8])
+	if !okHour || !okMinute || !okSecond || hour > 23 || minute > 59 || second > 60 {
+		return false
+	}
+
+	// time-secfrac = "." 1*DIGIT
+	i := 8
+	if s[i] == '.' {
+		i++
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == start || i == len(s) {
+			return false
+		}
+	}
+
+	// time-offset = "Z" / time-numoffset
+	offset := 0
+	switch s[i] {
+	case 'Z', 'z':
+		if i+1 != len(s) {
+			return false
+		}
+	case '+', '-':
+		if len(s)-i != 6 || s[i+3] != ':' {
+			return false
+		}
+		offsetHour, okOffsetHour := rfc3339Number(s[i+1 : i+3])
+		offsetMinute, okOffsetMinute := rfc3339Number(s[i+4 : i+6])
+		if !okOffsetHour || !okOffsetMinute || offsetHour > 23 || offsetMinute > 59 {
+			return false
+		}
+		offset = offsetHour*60 + offsetMinute
+		if s[i] == '-' {
+			offset = -offset
+		}
+	default:
+		return false
+	}
+
+	if second == 60 {
+		utc := ((hour*60+minute-offset)%1440 + 1440) % 1440
+		return utc == 23*60+59
+	}
+
+	return true
+}
+
+// rfc3339Number parses a fixed number of ASCII digits
+func rfc3339Number(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+		n = n*10 + int(s[i]-'0')
+	}
+	return n, true
+}
+
+// rfc3339DaysIn returns the number of days of a month ( RFC3339 Appendix C )
+func rfc3339DaysIn(month int, year int) int {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
@@ -31,6 +31,7 @@ import (
 	"errors"
 	"reflect"
 	"regexp"
+	"sync"
 
 	"github.com/xeipuuv/gojsonreference"
 )
@@ -42,54 +43,9 @@ var (
 	regexpCache map[string]*regexp.Regexp = map[string]*regexp.Regexp{}
 )
 
-func regexpCompile(key string) (*regexp.Regexp, error) {
-	if re, ok := regexpCache[key]; ok {
-		return re, nil
-	}
-
-	re, err := regexp.Compile(key)
-	if err != nil {
-		return nil, err
-	}
-
-	regexpCache[key] = re
-	return re, nil
-}
-
+// NewSchema loads and compiles a schema with the default SchemaLoader options
 func NewSchema(l JSONLoader) (*Schema, error) {
-	ref, err := l.JsonReference()
-	if err != nil {
-		return nil, err
-	}
-
-	d := Schema{}
-	d.pool = newSchemaPool(l.LoaderFactory())
-	d.documentReference = ref
-	d.referencePool = newSchemaReferencePool()
-
-	var doc interface{}
-	if ref.String() != "#" {
-		// Get document from schema pool
-		spd, err := d.pool.GetDocument(d.documentReference)
-		if err != nil {
-			return nil, err
-		}
-		doc = spd.Document
-	} else {
-		// Load JSON directly
-		doc, err = l.LoadJSON()
-		if err != nil {
-			return nil, err
-		}
-		d.pool.SetStandaloneDocument(doc)
-	}
-
-	err = d.parse(doc)
-	if err != nil {
-		return nil, err
-	}
-
-	return &d, nil
+	return NewSchemaLoader().Compile(l)
 }
 
 type Schema struct {
@@ -97,6 +53,21 @@ type Schema struct {
 	rootSchema        *subSchema
 	pool              *schemaPool
 	referencePool     *schemaReferencePool
+	keywords          map[string]Keyword
+	formatAssertion   bool
+	annotations       bool
+	unknownFormats    UnknownFormatMode
+	regexpEngine      RegexpEngine
+
+	// read-only views, see Root()
+	nodesMutex sync.Mutex
+	nodes      map[*subSchema]*SchemaNode
+
+	// checks the documents of the schema, nil when there is nothing to check
+	checkDocument func(document interface{}, context *JsonContext) (*Result, error)
+
+	// guards the subschemas parsed after compilation, see ValidateAt()
+	referencesMutex sync.Mutex
 }
 
 func (d *Schema) parse(document interface{}) error {
@@ -108,6 +79,21 @@ func (d *Schema) SetRootSchemaName(name string) {
 	d.rootSchema.property = name
 }
 
+// document returns the JSON document the root schema was parsed from
+func (d *Schema) document() (interface{}, error) {
+
+	if standaloneDocument := d.pool.GetStandaloneDocument(); standaloneDocument != nil {
+		return standaloneDocument, nil
+	}
+
+	spd, err := d.pool.GetDocument(d.documentReference)
+	if err != nil {
+		return nil, err
+	}
+
+	return spd.Document, nil
+}
+
 // Parses a subSchema
 //
 // Pretty long function ( sorry :) )... but pretty straight forward, repetitive and boring
@@ -165,7 +151,11 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 		oneOfV,
 		anyOfV,
 		allOfV,
-		notV interface{}
+		notV,
+		examplesV,
+		readOnlyV,
+		writeOnlyV,
+		deprecatedV interface{}
 	)
 
 	for k, v := range m {
@@ -178,6 +168,8 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 			definitionsV = v
 		case KEY_ID:
 			idV = v
+		case KEY_TITLE:
+			titleV = v
 		case KEY_DESCRIPTION:
 			descriptionV = v
 		case KEY_TYPE:
@@ -234,6 +226,14 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 			allOfV = v
 		case KEY_NOT:
 			notV = v
+		case KEY_EXAMPLES:
+			examplesV = v
+		case KEY_READ_ONLY:
+			readOnlyV = v
+		case KEY_WRITE_ONLY:
+			writeOnlyV = v
+		case KEY_DEPRECATED:
+			deprecatedV = v
 		}
 	}
 
@@ -259,9 +259,21 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 	// $ref
 	if refV != nil {
 		if k, ok := refV.(string); ok {
-			if sch, ok := d.referencePool.Get(currentSchema.ref.String() + k); ok {
+			currentSchema.reference = k
+			jsonReference, err := d.resolveReference(currentSchema, k)
+			if err != nil {
+				return err
+			}
+			currentSchema.resolvedReference = jsonReference
+			if sch, ok := d.referencePool.Get(jsonReference.String()); ok {
 				currentSchema.refSchema = sch
 			} else {
+				// definitions beside the $ref are parsed before the reference changes the base
+				if definitionsV != nil {
+					if err := d.parseDefinitions(definitionsV, currentSchema); err != nil {
+						return err
+					}
+				}
 				return d.parseReference(documentNode, currentSchema, k)
 			}
 		} else {
@@ -277,34 +289,8 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 
 	// definitions
 	if definitionsV != nil {
-		if defs, ok := definitionsV.(map[string]interface{}); ok {
-			currentSchema.definitions = make(map[string]*subSchema)
-			for dk, dv := range defs {
-				if isKind(dv, reflect.Map) {
-					newSchema := &subSchema{property: KEY_DEFINITIONS, parent: currentSchema, ref: currentSchema.ref}
-					currentSchema.definitions[dk] = newSchema
-					err := d.parseSchema(dv, newSchema, true)
-					if err != nil {
-						return errors.New(err.Error())
-					}
-				} else {
-					return errors.New(formatErrorDescription(
-						Locale.InvalidType(),
-						ErrorDetails{
-							"expected": STRING_ARRAY_OF_SCHEMAS,
-							"given":    KEY_DEFINITIONS,
-						},
-					))
-				}
-			}
-		} else {
-			return errors.New(formatErrorDescription(
-				Locale.InvalidType(),
-				ErrorDetails{
-					"expected": STRING_ARRAY_OF_SCHEMAS,
-					"given":    KEY_DEFINITIONS,
-				},
-			))
+		if err := d.parseDefinitions(definitionsV, currentSchema); err != nil {
+			return err
 		}
 	}
 
@@ -353,6 +339,54 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 		}
 	}
 
+	// examples
+	if examplesV != nil {
+		if k, ok := examplesV.([]interface{}); ok {
+			currentSchema.examples = k
+		} else {
+			return errors.New(formatErrorDescription(
+				Locale.MustBeOfAn(),
+				ErrorDetails{"x": KEY_EXAMPLES, "y": TYPE_ARRAY},
+			))
+		}
+	}
+
+	// readOnly
+	if readOnlyV != nil {
+		if k, ok := readOnlyV.(bool); ok {
+			currentSchema.readOnly = k
+		} else {
+			return errors.New(formatErrorDescription(
+				Locale.MustBeOfA(),
+				ErrorDetails{"x": KEY_READ_ONLY, "y": TYPE_BOOLEAN},
+			))
+		}
+	}
+
+	// writeOnly
+	if writeOnlyV != nil {
+		if k, ok := writeOnlyV.(bool); ok {
+			currentSchema.writeOnly = k
+		} else {
+			return errors.New(formatErrorDescription(
+				Locale.MustBeOfA(),
+				ErrorDetails{"x": KEY_WRITE_ONLY, "y": TYPE_BOOLEAN},
+			))
+		}
+	}
+
+	// deprecated
+	if deprecatedV != nil {
+		if k, ok := deprecatedV.(bool); ok {
+			currentSchema.deprecated = k
+		} else {
+			return errors.New(formatErrorDescription(
+				Locale.MustBeOfA(),
+				ErrorDetails{"x": KEY_DEPRECATED, "y": TYPE_BOOLEAN},
+			))
+		}
+	}
+
 	// type
 	if typeV != nil {
 		switch typeV.(type) {
@@ -422,13 +456,16 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 		if patternPropertiesMap, ok := patternPropsV.(map[string]interface{}); ok {
 			if len(patternPropertiesMap) > 0 {
 				currentSchema.patternProperties = make(map[string]*subSchema)
+				currentSchema.patternPropertiesRegexps = make(map[string]Regexp)
 				for k, v := range patternPropertiesMap {
-					if _, err := regexpCompile(k); err != nil {
+					regexpObject, err := d.regexpEngine.Compile(k)
+					if err != nil {
 						return errors.New(formatErrorDescription(
 							Locale.RegexPattern(),
 							ErrorDetails{"pattern": k},
 						))
 					}
+					currentSchema.patternPropertiesRegexps[k] = regexpObject
 
 					newSchema := &subSchema{property: k, parent: currentSchema, ref: currentSchema.ref}
 					if err := d.parseSchema(v, newSchema, false); err != nil {
@@ -538,6 +575,7 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 			))
 		}
 		currentSchema.multipleOf = multipleOfValue
+		currentSchema.keepNumber(KEY_MULTIPLE_OF, multipleOfV)
 	}
 
 	if minimumV != nil {
@@ -549,6 +587,7 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 			))
 		}
 		currentSchema.minimum = minimumValue
+		currentSchema.keepNumber(KEY_MINIMUM, minimumV)
 	}
 
 	if exclusiveMinimumV != nil {
@@ -577,6 +616,7 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 			))
 		}
 		currentSchema.maximum = maximumValue
+		currentSchema.keepNumber(KEY_MAXIMUM, maximumV)
 	}
 
 	if exclusiveMaximumV != nil {
@@ -652,7 +692,7 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 
 	if patternV != nil {
 		if k, ok := patternV.(string); ok {
-			regexpObject, err := regexpCompile(k)
+			regexpObject, err := d.regexpEngine.Compile(k)
 			if err != nil {
 				return errors.New(formatErrorDescription(
 					Locale.MustBeValidRegex(),
@@ -670,7 +710,7 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 
 	if formatV != nil {
 		formatString, ok := formatV.(string)
-		if ok && FormatCheckers.Has(formatString) {
+		if ok && (FormatCheckers.Has(formatString) || d.unknownFormats != UNKNOWN_FORMAT_ERROR) {
 			currentSchema.format = formatString
 		} else {
 			return errors.New(formatErrorDescription(
@@ -879,6 +919,59 @@ func (d *Schema) parseSchema(documentNode interface{}, currentSchema *subSchema,
 		}
 	}
 
+	// custom keywords
+	for _, k := range sortedKeys(m) {
+		keyword, ok := d.keywords[k]
+		if !ok {
+			continue
+		}
+		validator, err := keyword.Compile(m[k], m)
+		if err != nil {
+			return err
+		}
+		if validator != nil {
+			currentSchema.customKeywords = append(currentSchema.customKeywords, validator)
+		}
+		if currentSchema.customKeywordValues == nil {
+			currentSchema.customKeywordValues = make(map[string]interface{})
+		}
+		currentSchema.customKeywordValues[k] = m[k]
+	}
+
+	return nil
+}
+
+func (d *Schema) parseDefinitions(definitionsV interface{}, currentSchema *subSchema) error {
+	if defs, ok := definitionsV.(map[string]interface{}); ok {
+		currentSchema.definitions = make(map[string]*subSchema)
+		for dk, dv := range defs {
+			if isKind(dv, reflect.Map) {
+				newSchema := &subSchema{property: KEY_DEFINITIONS, parent: currentSchema, ref: currentSchema.ref}
+				currentSchema.definitions[dk] = newSchema
+				err := d.parseSchema(dv, newSchema, true)
+				if err != nil {
+					return errors.New(err.Error())
+				}
+			} else {
+				return errors.New(formatErrorDescription(
+					Locale.InvalidType(),
+					ErrorDetails{
+						"expected": STRING_ARRAY_OF_SCHEMAS,
+						"given":    KEY_DEFINITIONS,
+					},
+				))
+			}
+		}
+	} else {
+		return errors.New(formatErrorDescription(
+			Locale.InvalidType(),
+			ErrorDetails{
+				"expected": STRING_ARRAY_OF_SCHEMAS,
+				"given":    KEY_DEFINITIONS,
+			},
+		))
+	}
+
 	return nil
 }
 
@@ -886,28 +979,19 @@ func (d *Schema) parseReference(documentNode interface{}, currentSchema *subSche
 
 	var err error
 
-	jsonReference, err := gojsonreference.NewJsonReference(reference)
+	currentSchema.ref, err = d.resolveReference(currentSchema, reference)
 	if err != nil {
 		return err
 	}
 
 	standaloneDocument := d.pool.GetStandaloneDocument()
 
-	if jsonReference.HasFullUrl {
-		currentSchema.ref = &jsonReference
-	} else {
-		inheritedReference, err := currentSchema.ref.Inherits(jsonReference)
-		if err != nil {
-			return err
-		}
-		currentSchema.ref = inheritedReference
-	}
-
 	jsonPointer := currentSchema.ref.GetPointer()
 
 	var refdDocumentNode interface{}
+	checked := true
 
-	if standaloneDocument != nil {
+	if standaloneDocument != nil && !currentSchema.ref.HasFullUrl {
 
 		var err error
 		refdDocumentNode, _, err = jsonPointer.Get(standaloneDocument)
@@ -923,6 +1007,9 @@ func (d *Schema) parseReference(documentNode interface{}, currentSchema *subSche
 			return err
 		}
 
+		// the root document is checked as a whole, the others where they are referenced
+		checked = dsp.checked
+
 		refdDocumentNode, _, err = jsonPointer.Get(dsp.Document)
 		if err != nil {
 			return err
@@ -938,9 +1025,21 @@ func (d *Schema) parseReference(documentNode interface{}, currentSchema *subSche
 		))
 	}
 
+	if !checked && d.checkDocument != nil {
+		result, err := d.checkDocument(newSchemaDocument, jsonContextOf(jsonPointer.String()))
+		if err != nil {
+			return err
+		}
+		if !result.Valid() {
+			document := *currentSchema.ref.GetUrl()
+			document.Fragment = ""
+			return &InvalidSchemaError{result: result, document: document.String()}
+		}
+	}
+
 	// returns the loaded referenced subSchema for the caller to update its current subSchema
 	newSchema := &subSchema{property: KEY_REF, parent: currentSchema, ref: currentSchema.ref}
-	d.referencePool.Add(currentSchema.ref.String()+reference, newSchema)
+	d.referencePool.Add(currentSchema.ref.String(), newSchema)
 
 	err = d.parseSchema(newSchemaDocument, newSchema, true)
 	if err != nil {
@@ -953,6 +1052,21 @@ func (d *Schema) parseReference(documentNode interface{}, currentSchema *subSche
 
 }
 
+// resolveReference returns the absolute reference of a $ref found in currentSchema
+func (d *Schema) resolveReference(currentSchema *subSchema, reference string) (*gojsonreference.JsonReference, error) {
+
+	jsonReference, err := gojsonreference.NewJsonReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if jsonReference.HasFullUrl {
+		return &jsonReference, nil
+	}
+
+	return currentSchema.ref.Inherits(jsonReference)
+}
+
 func (d *Schema) parseProperties(documentNode interface{}, currentSchema *subSchema) error {
 	m, ok := documentNode.(map[string]interface{})
 
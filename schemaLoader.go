@@ -0,0 +1,159 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      SchemaLoader, compiles schemas with options.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+// UnknownFormatMode is the behavior for formats without a FormatChecker
+type UnknownFormatMode int
+
+const (
+	// UNKNOWN_FORMAT_ERROR fails the compilation of the schema
+	UNKNOWN_FORMAT_ERROR UnknownFormatMode = iota
+	// UNKNOWN_FORMAT_IGNORE accepts any instance
+	UNKNOWN_FORMAT_IGNORE
+	// UNKNOWN_FORMAT_FAIL rejects any instance with a format error
+	UNKNOWN_FORMAT_FAIL
+)
+
+// SchemaLoader compiles schemas, its fields are the compilation options
+type SchemaLoader struct {
+	// Validate the schema document against the draft-04 meta-schema before
+	// compiling it. When the document is invalid, Compile returns an
+	// *InvalidSchemaError holding every error found.
+	Validate bool
+
+	// Strict rejects keywords that draft-04 does not define, so a typo such as
+	// "requried" fails the compilation instead of being silently ignored.
+	// Keywords starting with "x-" and the ones of AllowedKeywords are accepted.
+	// Unknown keywords are reported by an *InvalidSchemaError, one
+	// UnknownKeywordError per keyword.
+	Strict bool
+
+	// AllowedKeywords are the extra keywords accepted in strict mode
+	AllowedKeywords []string
+
+	// Keywords are custom keywords specific to the schemas compiled by this
+	// loader, in addition to CustomKeywords. They are accepted in strict mode.
+	Keywords map[string]Keyword
+
+	// FormatAssertion makes "format" a validation keyword. When false, format
+	// is an annotation only : instances are not checked and the format names
+	// are collected in Result.Annotations() with the other annotations.
+	FormatAssertion bool
+
+	// Annotations collects the annotations of the valid schemas in
+	// Result.Annotations(), none are collected when false
+	Annotations bool
+
+	// UnknownFormats is the behavior for formats without a FormatChecker
+	UnknownFormats UnknownFormatMode
+
+	// RegexpEngine compiles the regular expressions of the schema,
+	// GoRegexpEngine when nil. Use ECMARegexpEngine for schemas written
+	// for JavaScript validators.
+	RegexpEngine RegexpEngine
+}
+
+// NewSchemaLoader returns a SchemaLoader with the default options
+func NewSchemaLoader() *SchemaLoader {
+	return &SchemaLoader{
+		Validate:        true,
+		FormatAssertion: true,
+		UnknownFormats:  UNKNOWN_FORMAT_ERROR,
+	}
+}
+
+// Compile loads and parses a schema
+func (sl *SchemaLoader) Compile(l JSONLoader) (*Schema, error) {
+
+	ref, err := l.JsonReference()
+	if err != nil {
+		return nil, err
+	}
+
+	d := Schema{}
+	d.pool = newSchemaPool(l.LoaderFactory())
+	d.documentReference = ref
+	d.referencePool = newSchemaReferencePool()
+	d.keywords = keywordsOf(sl)
+	d.formatAssertion = sl.FormatAssertion
+	d.annotations = sl.Annotations
+	d.unknownFormats = sl.UnknownFormats
+	d.regexpEngine = sl.RegexpEngine
+	if d.regexpEngine == nil {
+		d.regexpEngine = GoRegexpEngine{}
+	}
+
+	allowed := append([]string{}, sl.AllowedKeywords...)
+	for name := range d.keywords {
+		allowed = append(allowed, name)
+	}
+	// every document of the schema, the root and the ones loaded by a $ref, is checked
+	d.checkDocument = func(doc interface{}, context *JsonContext) (*Result, error) {
+		result := &Result{}
+		if sl.Validate {
+			metaResult, err := validateMetaSchema(doc, context, d.regexpEngine)
+			if err != nil {
+				return nil, err
+			}
+			result.mergeErrors(metaResult)
+		}
+		result.mergeErrors(newSchemaChecker(sl, d.regexpEngine, allowed).checkSchemaDocument(doc, context))
+		return result, nil
+	}
+
+	var doc interface{}
+	if ref.String() != "" && ref.String() != "#" {
+		// Get document from schema pool
+		spd, err := d.pool.GetDocument(d.documentReference)
+		if err != nil {
+			return nil, err
+		}
+		spd.checked = true
+		doc = spd.Document
+	} else {
+		// Load JSON directly
+		doc, err = l.LoadJSON()
+		if err != nil {
+			return nil, err
+		}
+		d.pool.SetStandaloneDocument(doc)
+	}
+
+	result, err := d.checkDocument(doc, NewJsonContext(STRING_CONTEXT_ROOT, nil))
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid() {
+		return nil, &InvalidSchemaError{result: result}
+	}
+
+	err = d.parse(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -0,0 +1,407 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Serialization of a compiled schema back to a schema document.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// Document returns the schema document as compiled : the keywords understood
+// by the parser and the custom keywords, keywords without effect ( default,
+// unknown ones ) are not kept. The schemas a $ref points to at other locations
+// of the document, as "#/percent%25field", are kept as well.
+//
+// With inlineReferences, every $ref is replaced by the schema it resolves to.
+// A $ref to a schema enclosing it is kept, as its absolute reference.
+func (d *Schema) Document(inlineReferences bool) map[string]interface{} {
+
+	w := &schemaWriter{inlineReferences: inlineReferences, inlining: map[string]bool{}}
+	if inlineReferences {
+		return w.document(d.rootSchema, referenceLocation(d.rootSchema.ref))
+	}
+
+	w.targets = map[string]*subSchema{}
+	w.targetDocument = documentUrl(d.documentReference)
+	document := w.document(d.rootSchema, referenceLocation(d.rootSchema.ref))
+	w.keepTargets(document)
+
+	return document
+}
+
+// MarshalJSON returns the schema document as compiled, references kept,
+// keys in sorted order
+func (d *Schema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Document(false))
+}
+
+// MarshalJSON returns the document of the subschema, references kept
+func (s *subSchema) MarshalJSON() ([]byte, error) {
+	return json.Marshal((&schemaWriter{}).document(s, ""))
+}
+
+// schemaWriter writes the documents of compiled schemas
+type schemaWriter struct {
+	inlineReferences bool
+	// inlining holds the locations of the schemas enclosing the one written
+	inlining map[string]bool
+	// cycles holds the references kept as they loop back to an enclosing schema
+	cycles []string
+	// cycleMarker is set to true on the $ref kept, when not empty
+	cycleMarker string
+	// cycleReference, when set, gives the $ref written for a kept reference
+	// to target, found at the absolute location reference
+	cycleReference func(reference string, target *subSchema) string
+	// simplifier merges the allOf of the schemas written, when set
+	simplifier *simplifier
+	// shallow writes the keywords of a schema only, its subschemas being left
+	// as *subSchema
+	shallow bool
+	// targets collects, when not nil, the schemas the $ref kept point to in
+	// the document at targetDocument, by JSON Pointer
+	targets        map[string]*subSchema
+	targetDocument string
+}
+
+// referenceLocation returns a reference as an absolute location, the fragment
+// being always present so that locations of a same document can be compared
+func referenceLocation(ref *gojsonreference.JsonReference) string {
+	if ref == nil {
+		return ""
+	}
+	location := ref.String()
+	if !strings.Contains(location, "#") {
+		location += "#"
+	}
+	return location
+}
+
+var jsonPointerTokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// child returns the document of a subschema, or the subschema itself when shallow
+func (w *schemaWriter) child(s *subSchema, location string) interface{} {
+	if w.shallow {
+		return s
+	}
+	return w.document(s, location)
+}
+
+// keepTargets adds to document the schemas its $ref point to where nothing was
+// written, the keywords not covering their location
+func (w *schemaWriter) keepTargets(document map[string]interface{}) {
+
+	// the targets are written first, as their own $ref may point elsewhere
+	documents := map[string]interface{}{}
+	for len(w.targets) > 0 {
+		targets := w.targets
+		w.targets = map[string]*subSchema{}
+		for pointer, target := range targets {
+			if _, ok := documents[pointer]; !ok {
+				documents[pointer] = w.document(target, "")
+			}
+		}
+	}
+
+	// enclosing locations come first
+	for _, pointer := range sortedKeys(documents) {
+		keepAt(document, pointer, documents[pointer])
+	}
+}
+
+// keepAt sets value at a JSON Pointer of document when nothing is there,
+// creating the objects on the way
+func keepAt(document map[string]interface{}, pointer string, value interface{}) {
+
+	if pointer == "" {
+		return
+	}
+
+	var current interface{} = document
+	tokens := strings.Split(pointer, "/")[1:]
+	for i, token := range tokens {
+		token = jsonPointerTokenUnescaper.Replace(token)
+		switch c := current.(type) {
+		case map[string]interface{}:
+			next, ok := c[token]
+			if !ok {
+				if i == len(tokens)-1 {
+					c[token] = value
+					return
+				}
+				next = map[string]interface{}{}
+				c[token] = next
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(c) {
+				return
+			}
+			current = c[index]
+		default:
+			return
+		}
+	}
+}
+
+// document builds the document of s found at location
+func (w *schemaWriter) document(s *subSchema, location string) map[string]interface{} {
+
+	m := make(map[string]interface{})
+
+	// $ref, the other keywords but definitions are ignored
+	if s.refSchema != nil {
+		if !w.inlineReferences {
+			m[KEY_REF] = s.reference
+			if w.targets != nil && s.resolvedReference != nil && s.resolvedReference.GetUrl() != nil && documentUrl(*s.resolvedReference) == w.targetDocument {
+				w.targets[s.resolvedReference.GetUrl().Fragment] = s.refSchema
+			}
+			if s.definitions != nil {
+				definitions := make(map[string]interface{}, len(s.definitions))
+				for name, child := range s.definitions {
+					definitions[name] = w.child(child, location+"/"+KEY_DEFINITIONS+"/"+jsonPointerTokenEscaper.Replace(name))
+				}
+				m[KEY_DEFINITIONS] = definitions
+			}
+			return m
+		}
+		reference := referenceLocation(s.resolvedReference)
+		if w.inlining[reference] {
+			m[KEY_REF] = reference
+			if w.cycleReference != nil {
+				m[KEY_REF] = w.cycleReference(reference, s.refSchema)
+			}
+			if w.cycleMarker != "" {
+				m[w.cycleMarker] = true
+			}
+			w.cycles = append(w.cycles, reference)
+			return m
+		}
+		return w.document(s.refSchema, reference)
+	}
+
+	if (w.inlineReferences || w.simplifier != nil) && location != "" && !w.inlining[location] {
+		w.inlining[location] = true
+		defer delete(w.inlining, location)
+	}
+
+	// sub returns the document of a child, tokens being the JSON Pointer from s
+	sub := func(child *subSchema, tokens ...string) interface{} {
+		childLocation := location
+		for _, token := range tokens {
+			childLocation += "/" + jsonPointerTokenEscaper.Replace(token)
+		}
+		return w.child(child, childLocation)
+	}
+	subs := func(keyword string, children []*subSchema) []interface{} {
+		documents := make([]interface{}, len(children))
+		for i, child := range children {
+			if keyword == KEY_ALL_OF && w.simplifier != nil && w.simplifier.inlinable(child, w.inlining) {
+				// the target is merged in place of the $ref
+				documents[i] = w.document(child.refSchema, referenceLocation(child.resolvedReference))
+				continue
+			}
+			documents[i] = sub(child, keyword, strconv.Itoa(i))
+		}
+		return documents
+	}
+	subsByName := func(keyword string, children map[string]*subSchema) map[string]interface{} {
+		documents := make(map[string]interface{}, len(children))
+		for name, child := range children {
+			documents[name] = sub(child, keyword, name)
+		}
+		return documents
+	}
+
+	// meta
+	if s.subSchema != nil {
+		m[KEY_SCHEMA] = s.subSchema.String()
+	}
+	if s.id != nil {
+		m[KEY_ID] = *s.id
+	}
+	if s.title != nil {
+		m[KEY_TITLE] = *s.title
+	}
+	if s.description != nil {
+		m[KEY_DESCRIPTION] = *s.description
+	}
+	if s.definitions != nil {
+		m[KEY_DEFINITIONS] = subsByName(KEY_DEFINITIONS, s.definitions)
+	}
+
+	// annotations
+	if s.examples != nil {
+		m[KEY_EXAMPLES] = s.examples
+	}
+	if s.readOnly {
+		m[KEY_READ_ONLY] = true
+	}
+	if s.writeOnly {
+		m[KEY_WRITE_ONLY] = true
+	}
+	if s.deprecated {
+		m[KEY_DEPRECATED] = true
+	}
+
+	// type
+	switch len(s.types.types) {
+	case 0:
+	case 1:
+		m[KEY_TYPE] = s.types.types[0]
+	default:
+		m[KEY_TYPE] = s.types.types
+	}
+
+	// number / integer, as written as float64 loses precision
+	if s.multipleOf != nil {
+		m[KEY_MULTIPLE_OF] = s.number(KEY_MULTIPLE_OF, *s.multipleOf)
+	}
+	if s.minimum != nil {
+		m[KEY_MINIMUM] = s.number(KEY_MINIMUM, *s.minimum)
+	}
+	if s.exclusiveMinimum {
+		m[KEY_EXCLUSIVE_MINIMUM] = true
+	}
+	if s.maximum != nil {
+		m[KEY_MAXIMUM] = s.number(KEY_MAXIMUM, *s.maximum)
+	}
+	if s.exclusiveMaximum {
+		m[KEY_EXCLUSIVE_MAXIMUM] = true
+	}
+
+	// string
+	if s.minLength != nil {
+		m[KEY_MIN_LENGTH] = *s.minLength
+	}
+	if s.maxLength != nil {
+		m[KEY_MAX_LENGTH] = *s.maxLength
+	}
+	if s.pattern != nil {
+		m[KEY_PATTERN] = s.pattern.String()
+	}
+	if s.format != "" {
+		m[KEY_FORMAT] = s.format
+	}
+
+	// object
+	if s.propertiesChildren != nil {
+		properties := make(map[string]interface{}, len(s.propertiesChildren))
+		for _, child := range s.propertiesChildren {
+			properties[child.property] = sub(child, KEY_PROPERTIES, child.property)
+		}
+		m[KEY_PROPERTIES] = properties
+	}
+	if s.patternProperties != nil {
+		m[KEY_PATTERN_PROPERTIES] = subsByName(KEY_PATTERN_PROPERTIES, s.patternProperties)
+	}
+	switch additional := s.additionalProperties.(type) {
+	case bool:
+		m[KEY_ADDITIONAL_PROPERTIES] = additional
+	case *subSchema:
+		m[KEY_ADDITIONAL_PROPERTIES] = sub(additional, KEY_ADDITIONAL_PROPERTIES)
+	}
+	if s.minProperties != nil {
+		m[KEY_MIN_PROPERTIES] = *s.minProperties
+	}
+	if s.maxProperties != nil {
+		m[KEY_MAX_PROPERTIES] = *s.maxProperties
+	}
+	if s.required != nil {
+		m[KEY_REQUIRED] = s.required
+	}
+	if s.dependencies != nil {
+		dependencies := make(map[string]interface{}, len(s.dependencies))
+		for name, dependency := range s.dependencies {
+			switch dependency := dependency.(type) {
+			case []string:
+				dependencies[name] = dependency
+			case *subSchema:
+				dependencies[name] = sub(dependency, KEY_DEPENDENCIES, name)
+			}
+		}
+		m[KEY_DEPENDENCIES] = dependencies
+	}
+
+	// array
+	if s.itemsChildren != nil {
+		if s.itemsChildrenIsSingleSchema {
+			m[KEY_ITEMS] = sub(s.itemsChildren[0], KEY_ITEMS)
+		} else {
+			m[KEY_ITEMS] = subs(KEY_ITEMS, s.itemsChildren)
+		}
+	}
+	switch additional := s.additionalItems.(type) {
+	case bool:
+		m[KEY_ADDITIONAL_ITEMS] = additional
+	case *subSchema:
+		m[KEY_ADDITIONAL_ITEMS] = sub(additional, KEY_ADDITIONAL_ITEMS)
+	}
+	if s.minItems != nil {
+		m[KEY_MIN_ITEMS] = *s.minItems
+	}
+	if s.maxItems != nil {
+		m[KEY_MAX_ITEMS] = *s.maxItems
+	}
+	if s.uniqueItems {
+		m[KEY_UNIQUE_ITEMS] = true
+	}
+
+	// all
+	if s.enum != nil {
+		// values are kept as JSON
+		enum := make([]json.RawMessage, len(s.enum))
+		for i, e := range s.enum {
+			enum[i] = json.RawMessage(e)
+		}
+		m[KEY_ENUM] = enum
+	}
+	if s.oneOf != nil {
+		m[KEY_ONE_OF] = subs(KEY_ONE_OF, s.oneOf)
+	}
+	if s.anyOf != nil {
+		m[KEY_ANY_OF] = subs(KEY_ANY_OF, s.anyOf)
+	}
+	if s.allOf != nil {
+		m[KEY_ALL_OF] = subs(KEY_ALL_OF, s.allOf)
+	}
+	if s.not != nil {
+		m[KEY_NOT] = sub(s.not, KEY_NOT)
+	}
+
+	for k, v := range s.customKeywordValues {
+		m[k] = v
+	}
+
+	if w.simplifier != nil {
+		return w.simplifier.simplify(m, location)
+	}
+
+	return m
+}
@@ -0,0 +1,187 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the serialization of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaMarshalJSON(t *testing.T) {
+
+	document := `{
+		"title": "Order",
+		"type": ["object", "null"],
+		"properties": {
+			"id": {"type": "integer", "minimum": 1, "exclusiveMinimum": true, "readOnly": true},
+			"status": {"enum": ["open", 2, {"a": null}]},
+			"lines": {
+				"type": "array",
+				"items": {"$ref": "#/definitions/line"},
+				"minItems": 1,
+				"uniqueItems": true
+			},
+			"pair": {"items": [{"type": "string"}, {"type": "number"}], "additionalItems": false},
+			"code": {"type": "string", "pattern": "^[A-Z]+$", "format": "date", "maxLength": 8}
+		},
+		"patternProperties": {"^x-": {}},
+		"additionalProperties": false,
+		"dependencies": {"code": ["id"], "pair": {"required": ["lines"]}},
+		"required": ["id", "lines"],
+		"definitions": {
+			"line": {"anyOf": [{"type": "string"}, {"not": {"type": "null"}}], "multipleOf": 0.5}
+		}
+	}`
+
+	schema, err := NewSchema(NewStringLoader(document))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	marshaled, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	assert.JSONEq(t, document, string(marshaled))
+
+	// keys are sorted, the output is stable
+	again, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	assert.Equal(t, string(marshaled), string(again))
+
+	// keywords without effect are not kept
+	schema, err = NewSchema(NewStringLoader(`{"type": "string", "default": "a"}`))
+	if assert.Nil(t, err) {
+		assert.Equal(t, map[string]interface{}{"type": "string"}, schema.Document(false))
+	}
+}
+
+func TestSchemaMarshalJSONCompilesAgain(t *testing.T) {
+
+	document := `{
+		"$ref": "#/definitions/id",
+		"definitions": {
+			"id": {"type": "integer", "minimum": 9007199254740993, "maximum": 1e30, "multipleOf": 2}
+		}
+	}`
+
+	schema, err := NewSchema(NewStringLoader(document))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	marshaled, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	assert.JSONEq(t, document, string(marshaled))
+
+	// the output compiles to the same schema
+	again, err := NewSchema(NewStringLoader(string(marshaled)))
+	if !assert.Nil(t, err) {
+		return
+	}
+	minimum, _ := again.Root().Minimum()
+	assert.Equal(t, json.Number("9007199254740993"), minimum)
+
+	result, err := again.Validate(NewStringLoader(`9007199254740994`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid())
+	result, err = again.Validate(NewStringLoader(`"a"`))
+	assert.Nil(t, err)
+	assert.False(t, result.Valid())
+}
+
+func TestSchemaDocumentTestSuite(t *testing.T) {
+
+	forEachTestSuiteSchema(t, func(path string, original *Schema) {
+		sl := NewSchemaLoader()
+		sl.UnknownFormats = UNKNOWN_FORMAT_IGNORE
+		schema, err := sl.Compile(NewGoLoader(original.Document(false)))
+		if !assert.Nil(t, err, path) {
+			return
+		}
+
+		names, instances := testSuiteInstances(path)
+		for i, instance := range instances {
+			expected, err := original.Validate(instance)
+			if !assert.Nil(t, err, names[i]) {
+				continue
+			}
+			result, err := schema.Validate(instance)
+			if assert.Nil(t, err, names[i]) {
+				assert.Equal(t, expected.Valid(), result.Valid(), names[i])
+			}
+		}
+	})
+
+	// a $ref to a location which is not a keyword
+	schema, err := NewSchema(NewStringLoader(`{"properties": {"a": {"$ref": "#/x/percent%25field"}}, "x": {"percent%field": {"$ref": "#/y/0"}}, "y": [{"type": "integer"}]}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	marshaled, err := json.Marshal(schema)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"properties": {"a": {"$ref": "#/x/percent%25field"}},
+		"x": {"percent%field": {"$ref": "#/y/0"}},
+		"y": {"0": {"type": "integer"}}
+	}`, string(marshaled))
+
+	again, err := NewSchema(NewStringLoader(string(marshaled)))
+	if !assert.Nil(t, err) {
+		return
+	}
+	result, err := again.Validate(NewStringLoader(`{"a": "1"}`))
+	assert.Nil(t, err)
+	assert.False(t, result.Valid())
+}
+
+func TestSchemaDocumentInlineReferences(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"address": {"$ref": "#/definitions/address"},
+			"parent": {"$ref": "#"}
+		},
+		"definitions": {
+			"address": {"properties": {"next": {"$ref": "#/definitions/address"}, "city": {"type": "string"}}}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	inlined, err := json.Marshal(schema.Document(true))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"properties": {
+			"address": {"properties": {"next": {"$ref": "#/definitions/address"}, "city": {"type": "string"}}},
+			"parent": {"$ref": "#"}
+		},
+		"definitions": {
+			"address": {"properties": {"next": {"$ref": "#/definitions/address"}, "city": {"type": "string"}}}
+		}
+	}`, string(inlined))
+}
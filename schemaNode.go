@@ -0,0 +1,332 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Read-only view of a compiled schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import "encoding/json"
+
+// SchemaNode is a read-only view of a compiled (sub)schema.
+//
+// A node holding a $ref reads the keywords of the schema it references, so a
+// recursive schema is a graph with cycles : a node is always returned as the
+// same *SchemaNode, and Resolved() gives the node actually holding the
+// keywords, to be used to detect the nodes already visited.
+type SchemaNode struct {
+	schema *Schema
+	s      *subSchema
+}
+
+// Root returns the root node of the schema
+func (d *Schema) Root() *SchemaNode {
+	return d.node(d.rootSchema)
+}
+
+// node returns the unique SchemaNode of a subSchema
+func (d *Schema) node(s *subSchema) *SchemaNode {
+
+	if s == nil {
+		return nil
+	}
+
+	d.nodesMutex.Lock()
+	defer d.nodesMutex.Unlock()
+
+	if d.nodes == nil {
+		d.nodes = make(map[*subSchema]*SchemaNode)
+	}
+	n, ok := d.nodes[s]
+	if !ok {
+		n = &SchemaNode{schema: d, s: s}
+		d.nodes[s] = n
+	}
+
+	return n
+}
+
+func (n *SchemaNode) nodes(schemas []*subSchema) []*SchemaNode {
+	if len(schemas) == 0 {
+		return nil
+	}
+	nodes := make([]*SchemaNode, len(schemas))
+	for i, s := range schemas {
+		nodes[i] = n.schema.node(s)
+	}
+	return nodes
+}
+
+// resolved follows the $ref links, stopping on a cycle of references
+func (n *SchemaNode) resolved() *subSchema {
+	s := n.s
+	visited := map[*subSchema]bool{}
+	for s.refSchema != nil && !visited[s.refSchema] {
+		visited[s] = true
+		s = s.refSchema
+	}
+	return s
+}
+
+// Resolved returns the node holding the keywords, following the $ref links
+func (n *SchemaNode) Resolved() *SchemaNode {
+	return n.schema.node(n.resolved())
+}
+
+// Ref returns the absolute reference of the $ref of the node, or "" when there is none
+func (n *SchemaNode) Ref() string {
+	if n.s.refSchema == nil || n.s.resolvedReference == nil {
+		return ""
+	}
+	return n.s.resolvedReference.String()
+}
+
+// Types returns the types allowed by the node, none meaning any type
+func (n *SchemaNode) Types() []string {
+	return append([]string(nil), n.resolved().types.types...)
+}
+
+// Properties returns the nodes of the properties by property name
+func (n *SchemaNode) Properties() map[string]*SchemaNode {
+	s := n.resolved()
+	if len(s.propertiesChildren) == 0 {
+		return nil
+	}
+	properties := make(map[string]*SchemaNode, len(s.propertiesChildren))
+	for _, p := range s.propertiesChildren {
+		properties[p.property] = n.schema.node(p)
+	}
+	return properties
+}
+
+// PatternProperties returns the nodes of the patternProperties by pattern
+func (n *SchemaNode) PatternProperties() map[string]*SchemaNode {
+	s := n.resolved()
+	if len(s.patternProperties) == 0 {
+		return nil
+	}
+	properties := make(map[string]*SchemaNode, len(s.patternProperties))
+	for pattern, p := range s.patternProperties {
+		properties[pattern] = n.schema.node(p)
+	}
+	return properties
+}
+
+// AdditionalProperties returns whether additional properties are allowed,
+// and their node when it is a schema
+func (n *SchemaNode) AdditionalProperties() (bool, *SchemaNode) {
+	switch additional := n.resolved().additionalProperties.(type) {
+	case bool:
+		return additional, nil
+	case *subSchema:
+		return true, n.schema.node(additional)
+	}
+	return true, nil
+}
+
+// Required returns the required property names
+func (n *SchemaNode) Required() []string {
+	return append([]string(nil), n.resolved().required...)
+}
+
+// Items returns the nodes of items : a single node when all the items share the
+// same schema, one node per position when tuple is true
+func (n *SchemaNode) Items() (items []*SchemaNode, tuple bool) {
+	s := n.resolved()
+	return n.nodes(s.itemsChildren), len(s.itemsChildren) > 0 && !s.itemsChildrenIsSingleSchema
+}
+
+// AdditionalItems returns whether additional items are allowed after tuple
+// items, and their node when it is a schema
+func (n *SchemaNode) AdditionalItems() (bool, *SchemaNode) {
+	switch additional := n.resolved().additionalItems.(type) {
+	case bool:
+		return additional, nil
+	case *subSchema:
+		return true, n.schema.node(additional)
+	}
+	return true, nil
+}
+
+// Definitions returns the nodes of the definitions by name
+func (n *SchemaNode) Definitions() map[string]*SchemaNode {
+	s := n.resolved()
+	if len(s.definitions) == 0 {
+		return nil
+	}
+	definitions := make(map[string]*SchemaNode, len(s.definitions))
+	for name, d := range s.definitions {
+		definitions[name] = n.schema.node(d)
+	}
+	return definitions
+}
+
+// Enum returns the allowed values, numbers being json.Number
+func (n *SchemaNode) Enum() []interface{} {
+	return n.resolved().enumValues()
+}
+
+// AllOf returns the nodes of allOf
+func (n *SchemaNode) AllOf() []*SchemaNode {
+	return n.nodes(n.resolved().allOf)
+}
+
+// AnyOf returns the nodes of anyOf
+func (n *SchemaNode) AnyOf() []*SchemaNode {
+	return n.nodes(n.resolved().anyOf)
+}
+
+// OneOf returns the nodes of oneOf
+func (n *SchemaNode) OneOf() []*SchemaNode {
+	return n.nodes(n.resolved().oneOf)
+}
+
+// Not returns the node of not, or nil
+func (n *SchemaNode) Not() *SchemaNode {
+	return n.schema.node(n.resolved().not)
+}
+
+// MultipleOf returns multipleOf as written, or ""
+func (n *SchemaNode) MultipleOf() json.Number {
+	return n.resolved().numbers[KEY_MULTIPLE_OF]
+}
+
+// Minimum returns minimum as written, or "", and whether it is exclusive
+func (n *SchemaNode) Minimum() (minimum json.Number, exclusive bool) {
+	s := n.resolved()
+	return s.numbers[KEY_MINIMUM], s.exclusiveMinimum
+}
+
+// Maximum returns maximum as written, or "", and whether it is exclusive
+func (n *SchemaNode) Maximum() (maximum json.Number, exclusive bool) {
+	s := n.resolved()
+	return s.numbers[KEY_MAXIMUM], s.exclusiveMaximum
+}
+
+// MinLength returns minLength, ok being false when there is none
+func (n *SchemaNode) MinLength() (minLength int, ok bool) {
+	return intValue(n.resolved().minLength)
+}
+
+// MaxLength returns maxLength, ok being false when there is none
+func (n *SchemaNode) MaxLength() (maxLength int, ok bool) {
+	return intValue(n.resolved().maxLength)
+}
+
+// MinItems returns minItems, ok being false when there is none
+func (n *SchemaNode) MinItems() (minItems int, ok bool) {
+	return intValue(n.resolved().minItems)
+}
+
+// MaxItems returns maxItems, ok being false when there is none
+func (n *SchemaNode) MaxItems() (maxItems int, ok bool) {
+	return intValue(n.resolved().maxItems)
+}
+
+func intValue(i *int) (int, bool) {
+	if i == nil {
+		return 0, false
+	}
+	return *i, true
+}
+
+// UniqueItems returns uniqueItems
+func (n *SchemaNode) UniqueItems() bool {
+	return n.resolved().uniqueItems
+}
+
+// PropertyDependencies returns the property names required by a property, by property name
+func (n *SchemaNode) PropertyDependencies() map[string][]string {
+	var dependencies map[string][]string
+	for name, d := range n.resolved().dependencies {
+		if properties, ok := d.([]string); ok {
+			if dependencies == nil {
+				dependencies = make(map[string][]string)
+			}
+			dependencies[name] = append([]string(nil), properties...)
+		}
+	}
+	return dependencies
+}
+
+// SchemaDependencies returns the nodes applying when a property is present, by property name
+func (n *SchemaNode) SchemaDependencies() map[string]*SchemaNode {
+	var dependencies map[string]*SchemaNode
+	for name, d := range n.resolved().dependencies {
+		if s, ok := d.(*subSchema); ok {
+			if dependencies == nil {
+				dependencies = make(map[string]*SchemaNode)
+			}
+			dependencies[name] = n.schema.node(s)
+		}
+	}
+	return dependencies
+}
+
+// Format returns the format, or ""
+func (n *SchemaNode) Format() string {
+	return n.resolved().format
+}
+
+// Pattern returns the pattern, or ""
+func (n *SchemaNode) Pattern() string {
+	if pattern := n.resolved().pattern; pattern != nil {
+		return pattern.String()
+	}
+	return ""
+}
+
+// Title returns the title annotation, or ""
+func (n *SchemaNode) Title() string {
+	if title := n.resolved().title; title != nil {
+		return *title
+	}
+	return ""
+}
+
+// Description returns the description annotation, or ""
+func (n *SchemaNode) Description() string {
+	if description := n.resolved().description; description != nil {
+		return *description
+	}
+	return ""
+}
+
+// Examples returns the examples annotation
+func (n *SchemaNode) Examples() []interface{} {
+	return append([]interface{}(nil), n.resolved().examples...)
+}
+
+// ReadOnly returns the readOnly annotation
+func (n *SchemaNode) ReadOnly() bool {
+	return n.resolved().readOnly
+}
+
+// WriteOnly returns the writeOnly annotation
+func (n *SchemaNode) WriteOnly() bool {
+	return n.resolved().writeOnly
+}
+
+// Deprecated returns the deprecated annotation
+func (n *SchemaNode) Deprecated() bool {
+	return n.resolved().deprecated
+}
@@ -0,0 +1,148 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the read-only view of schemas.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaNode(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"title": "Tree",
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "pattern": "^[a-z]+$"},
+			"kind": {"enum": ["leaf", 2]},
+			"children": {"type": "array", "items": {"$ref": "#"}},
+			"loop": {"$ref": "#/definitions/a"}
+		},
+		"additionalProperties": false,
+		"definitions": {
+			"a": {"$ref": "#/definitions/b"},
+			"b": {"$ref": "#/definitions/a"}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	root := schema.Root()
+	assert.True(t, root == schema.Root())
+	assert.Equal(t, "Tree", root.Title())
+	assert.Equal(t, []string{TYPE_OBJECT}, root.Types())
+	assert.Equal(t, []string{"name"}, root.Required())
+	assert.Equal(t, "", root.Ref())
+
+	additional, node := root.AdditionalProperties()
+	assert.False(t, additional)
+	assert.Nil(t, node)
+
+	properties := root.Properties()
+	assert.Len(t, properties, 4)
+	assert.Equal(t, "^[a-z]+$", properties["name"].Pattern())
+	assert.Equal(t, []interface{}{"leaf", json.Number("2")}, properties["kind"].Enum())
+
+	// a $ref is followed, and its recursion gives back the same node
+	items, tuple := properties["children"].Items()
+	assert.False(t, tuple)
+	if assert.Len(t, items, 1) {
+		tree := items[0].Resolved()
+		assert.Equal(t, "Tree", items[0].Title())
+		assert.Equal(t, "^[a-z]+$", items[0].Properties()["name"].Pattern())
+		nested, _ := tree.Properties()["children"].Items()
+		if assert.Len(t, nested, 1) {
+			assert.True(t, nested[0].Resolved() == tree)
+		}
+	}
+
+	// a cycle of references resolves to a node of the cycle
+	loop := properties["loop"]
+	assert.Equal(t, "#/definitions/a", loop.Ref())
+	assert.NotNil(t, loop.Resolved())
+	assert.Empty(t, loop.Types())
+	assert.Len(t, root.Definitions(), 2)
+}
+
+func TestSchemaNodeValidationKeywords(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {
+			"id": {"type": "integer", "minimum": 9007199254740993, "maximum": 1e20, "exclusiveMaximum": true, "multipleOf": 3},
+			"name": {"type": "string", "minLength": 1, "maxLength": 20, "examples": ["ada"]},
+			"tags": {"type": "array", "minItems": 0, "uniqueItems": true}
+		},
+		"dependencies": {
+			"name": ["id"],
+			"tags": {"required": ["name"]}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	root := schema.Root()
+	properties := root.Properties()
+
+	minimum, exclusive := properties["id"].Minimum()
+	assert.Equal(t, json.Number("9007199254740993"), minimum)
+	assert.False(t, exclusive)
+	maximum, exclusive := properties["id"].Maximum()
+	assert.Equal(t, json.Number("1e20"), maximum)
+	assert.True(t, exclusive)
+	assert.Equal(t, json.Number("3"), properties["id"].MultipleOf())
+	assert.Equal(t, json.Number(""), properties["name"].MultipleOf())
+
+	minLength, ok := properties["name"].MinLength()
+	assert.True(t, ok)
+	assert.Equal(t, 1, minLength)
+	maxLength, ok := properties["name"].MaxLength()
+	assert.True(t, ok)
+	assert.Equal(t, 20, maxLength)
+
+	minItems, ok := properties["tags"].MinItems()
+	assert.True(t, ok)
+	assert.Equal(t, 0, minItems)
+	_, ok = properties["tags"].MaxItems()
+	assert.False(t, ok)
+	assert.True(t, properties["tags"].UniqueItems())
+	assert.False(t, properties["name"].UniqueItems())
+
+	assert.Equal(t, map[string][]string{"name": {"id"}}, root.PropertyDependencies())
+	dependencies := root.SchemaDependencies()
+	if assert.Len(t, dependencies, 1) {
+		assert.Equal(t, []string{"name"}, dependencies["tags"].Required())
+	}
+
+	// examples are a copy
+	examples := properties["name"].Examples()
+	examples[0] = "bob"
+	assert.Equal(t, []interface{}{"ada"}, properties["name"].Examples())
+}
@@ -34,6 +34,8 @@ import (
 
 type schemaPoolDocument struct {
 	Document interface{}
+	// checked is set when the whole document was checked against the meta-schema
+	checked bool
 }
 
 type schemaPool struct {
@@ -74,8 +76,9 @@ func (p *schemaPool) GetDocument(reference gojsonreference.JsonReference) (*sche
 		))
 	}
 
-	refToUrl := reference
-	refToUrl.GetUrl().Fragment = ""
+	// copy the url, the reference must not lose its fragment
+	refToUrl := *reference.GetUrl()
+	refToUrl.Fragment = ""
 	refToUrlStr := refToUrl.String()
 
 	// Try to find the requested document in the pool
@@ -88,7 +91,14 @@ func (p *schemaPool) GetDocument(reference gojsonreference.JsonReference) (*sche
 		}
 	}
 
-	jsonReferenceLoader := p.jsonLoaderFactory.New(refToUrlStr)
+	var jsonReferenceLoader JSONLoader
+	if refToUrlStr == draft04MetaSchemaUrl {
+		// the meta-schema is embedded, no need to download it
+		jsonReferenceLoader = NewStringLoader(draft04MetaSchemaSource)
+	} else {
+		jsonReferenceLoader = p.jsonLoaderFactory.New(refToUrlStr)
+	}
+
 	document, err := jsonReferenceLoader.LoadJSON()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,79 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema pool.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xeipuuv/gojsonreference"
+)
+
+func TestSchemaPoolGetDocumentKeepsFragment(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "gojsonschema")
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "definitions.json")
+	ioutil.WriteFile(path, []byte(`{"definitions": {"a": {"type": "integer"}}}`), 0644)
+
+	reference, err := gojsonreference.NewJsonReference("file://" + filepath.ToSlash(path) + "#/definitions/a")
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	pool := newSchemaPool(DefaultJSONLoaderFactory{})
+	document, err := pool.GetDocument(reference)
+	assert.Nil(t, err)
+	assert.NotNil(t, document)
+	assert.Equal(t, "/definitions/a", reference.GetUrl().Fragment)
+}
+
+func TestStandaloneSchemaRemoteReference(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"definitions": {"a": {"type": "integer"}}}`))
+	}))
+	defer server.Close()
+
+	// the remote document is loaded, not the standalone one
+	schema, err := NewSchema(NewStringLoader(`{"definitions": {"a": {"type": "string"}}, "$ref": "` + server.URL + `/definitions.json#/definitions/a"}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(NewStringLoader(`1`))
+	assert.Nil(t, err)
+	assert.True(t, result.Valid(), "%v", result.Errors())
+}
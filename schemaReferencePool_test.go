@@ -0,0 +1,61 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the schema reference pool.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaReferencePoolSharesTargets(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"definitions": {
+			"a": {"type": "integer"},
+			"b": {"properties": {"a": {"$ref": "#/definitions/a"}}}
+		},
+		"properties": {
+			"a": {"$ref": "#/definitions/a"},
+			"b": {"$ref": "#/definitions/b"}
+		}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	properties := map[string]*subSchema{}
+	for _, p := range schema.rootSchema.propertiesChildren {
+		properties[p.property] = p
+	}
+	if !assert.NotNil(t, properties["a"].refSchema) || !assert.NotNil(t, properties["b"].refSchema) {
+		return
+	}
+
+	// both $ref resolve to the same target, parsed once
+	nested := properties["b"].refSchema.propertiesChildren[0]
+	assert.True(t, properties["a"].refSchema == nested.refSchema)
+}
@@ -42,14 +42,8 @@ const displayErrorMessages = false
 var rxInvoice = regexp.MustCompile("^[A-Z]{2}-[0-9]{5}")
 var rxSplitErrors = regexp.MustCompile(", ?")
 
-// Used for remote schema in ref/schema_5.json that defines "uri" and "regex" types
-type alwaysTrueFormatChecker struct{}
 type invoiceFormatChecker struct{}
 
-func (a alwaysTrueFormatChecker) IsFormat(input string) bool {
-	return true
-}
-
 func (a invoiceFormatChecker) IsFormat(input string) bool {
 	return rxInvoice.MatchString(input)
 }
@@ -311,11 +305,11 @@ func TestJsonSchemaTestSuite(t *testing.T) {
 		map[string]string{"phase": "format validation", "test": "email format valid", "schema": "format/schema_0.json", "data": "format/data_02.json", "valid": "true"},
 		map[string]string{"phase": "format validation", "test": "invoice format valid", "schema": "format/schema_1.json", "data": "format/data_03.json", "valid": "true"},
 		map[string]string{"phase": "format validation", "test": "invoice format is invalid", "schema": "format/schema_1.json", "data": "format/data_04.json", "valid": "false", "errors": "format"},
-		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_05.json", "valid": "true"},
-		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_06.json", "valid": "true"},
+		map[string]string{"phase": "format validation", "test": "date-time format is invalid", "schema": "format/schema_2.json", "data": "format/data_05.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "format validation", "test": "date-time format is invalid", "schema": "format/schema_2.json", "data": "format/data_06.json", "valid": "false", "errors": "format"},
 		map[string]string{"phase": "format validation", "test": "date-time format is invalid", "schema": "format/schema_2.json", "data": "format/data_07.json", "valid": "false", "errors": "format"},
-		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_08.json", "valid": "false", "errors": "format"},
-		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_09.json", "valid": "true"},
+		map[string]string{"phase": "format validation", "test": "date-time format is invalid", "schema": "format/schema_2.json", "data": "format/data_08.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "format validation", "test": "date-time format is invalid", "schema": "format/schema_2.json", "data": "format/data_09.json", "valid": "false", "errors": "format"},
 		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_10.json", "valid": "true"},
 		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_11.json", "valid": "true"},
 		map[string]string{"phase": "format validation", "test": "date-time format is valid", "schema": "format/schema_2.json", "data": "format/data_12.json", "valid": "true"},
@@ -336,6 +330,61 @@ func TestJsonSchemaTestSuite(t *testing.T) {
 		map[string]string{"phase": "format validation", "test": "uri format is valid", "schema": "format/schema_6.json", "data": "format/data_27.json", "valid": "true"},
 		map[string]string{"phase": "format validation", "test": "uri format is invalid", "schema": "format/schema_6.json", "data": "format/data_28.json", "valid": "false", "errors": "format"},
 		map[string]string{"phase": "format validation", "test": "uri format is invalid", "schema": "format/schema_6.json", "data": "format/data_13.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "date format is valid", "schema": "format/schema_7.json", "data": "format/data_29.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "date format is invalid", "schema": "format/schema_7.json", "data": "format/data_30.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "date format is invalid", "schema": "format/schema_7.json", "data": "format/data_31.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "date format is invalid", "schema": "format/schema_7.json", "data": "format/data_32.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "time format is valid", "schema": "format/schema_8.json", "data": "format/data_33.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "time format is valid", "schema": "format/schema_8.json", "data": "format/data_34.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "time format is invalid", "schema": "format/schema_8.json", "data": "format/data_35.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "time format is invalid", "schema": "format/schema_8.json", "data": "format/data_36.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is valid", "schema": "format/schema_9.json", "data": "format/data_37.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is valid", "schema": "format/schema_9.json", "data": "format/data_38.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is valid", "schema": "format/schema_9.json", "data": "format/data_39.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is valid", "schema": "format/schema_9.json", "data": "format/data_40.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is invalid", "schema": "format/schema_9.json", "data": "format/data_41.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is invalid", "schema": "format/schema_9.json", "data": "format/data_42.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is invalid", "schema": "format/schema_9.json", "data": "format/data_43.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "duration format is invalid", "schema": "format/schema_9.json", "data": "format/data_44.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "uri-reference format is valid", "schema": "format/schema_10.json", "data": "format/data_45.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-reference format is valid", "schema": "format/schema_10.json", "data": "format/data_46.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-reference format is valid", "schema": "format/schema_10.json", "data": "format/data_47.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-reference format is invalid", "schema": "format/schema_10.json", "data": "format/data_48.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "uri-reference format is invalid", "schema": "format/schema_10.json", "data": "format/data_49.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "uri-template format is valid", "schema": "format/schema_11.json", "data": "format/data_50.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-template format is valid", "schema": "format/schema_11.json", "data": "format/data_51.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-template format is valid", "schema": "format/schema_11.json", "data": "format/data_52.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "uri-template format is invalid", "schema": "format/schema_11.json", "data": "format/data_53.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "iri format is valid", "schema": "format/schema_12.json", "data": "format/data_54.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "iri format is valid", "schema": "format/schema_12.json", "data": "format/data_55.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "iri format is invalid", "schema": "format/schema_12.json", "data": "format/data_56.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "iri format is invalid", "schema": "format/schema_12.json", "data": "format/data_57.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "iri-reference format is valid", "schema": "format/schema_13.json", "data": "format/data_58.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "iri-reference format is valid", "schema": "format/schema_13.json", "data": "format/data_59.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "iri-reference format is valid", "schema": "format/schema_13.json", "data": "format/data_60.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "iri-reference format is invalid", "schema": "format/schema_13.json", "data": "format/data_61.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "iri-reference format is invalid", "schema": "format/schema_13.json", "data": "format/data_62.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "idn-email format is valid", "schema": "format/schema_14.json", "data": "format/data_63.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "idn-email format is valid", "schema": "format/schema_14.json", "data": "format/data_64.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "idn-email format is invalid", "schema": "format/schema_14.json", "data": "format/data_65.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "idn-hostname format is valid", "schema": "format/schema_15.json", "data": "format/data_66.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "idn-hostname format is invalid", "schema": "format/schema_15.json", "data": "format/data_67.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "idn-hostname format is invalid", "schema": "format/schema_15.json", "data": "format/data_68.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is valid", "schema": "format/schema_16.json", "data": "format/data_69.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is valid", "schema": "format/schema_16.json", "data": "format/data_70.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is valid", "schema": "format/schema_16.json", "data": "format/data_71.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is invalid", "schema": "format/schema_16.json", "data": "format/data_72.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is invalid", "schema": "format/schema_16.json", "data": "format/data_73.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "json-pointer format is invalid", "schema": "format/schema_16.json", "data": "format/data_74.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is valid", "schema": "format/schema_17.json", "data": "format/data_75.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is valid", "schema": "format/schema_17.json", "data": "format/data_76.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is valid", "schema": "format/schema_17.json", "data": "format/data_77.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is valid", "schema": "format/schema_17.json", "data": "format/data_78.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is invalid", "schema": "format/schema_17.json", "data": "format/data_79.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is invalid", "schema": "format/schema_17.json", "data": "format/data_80.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "relative-json-pointer format is invalid", "schema": "format/schema_17.json", "data": "format/data_81.json", "valid": "false", "errors": "format"},
+		map[string]string{"phase": "optional format validation", "test": "regex format is valid", "schema": "format/schema_18.json", "data": "format/data_82.json", "valid": "true"},
+		map[string]string{"phase": "optional format validation", "test": "regex format is invalid", "schema": "format/schema_18.json", "data": "format/data_83.json", "valid": "false", "errors": "format"},
 	}
 
 	//TODO Pass failed tests : id(s) as scope for references is not implemented yet
@@ -358,9 +407,6 @@ func TestJsonSchemaTestSuite(t *testing.T) {
 		}
 	}()
 
-	// Used for remote schema in ref/schema_5.json that defines "regex" type
-	FormatCheckers.Add("regex", alwaysTrueFormatChecker{})
-
 	// Custom Formatter
 	FormatCheckers.Add("invoice", invoiceFormatChecker{})
 
@@ -0,0 +1,479 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Merging of the allOf branches of a schema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaConflict is a contradiction between keywords of a schema
+type SchemaConflict struct {
+	// Location is the JSON Pointer of the schema
+	Location    string
+	Keyword     string
+	Description string
+	// Unsatisfiable is set when no value satisfies the schema, the values of
+	// the types the keywords do not apply to being valid otherwise
+	Unsatisfiable bool
+}
+
+// Simplify returns the schema document with the allOf branches merged into
+// the schema holding them, wherever their keywords combine into equivalent
+// ones : types and enum are intersected, properties and required joined, the
+// tighter bounds kept. Branches that cannot be merged ( different patterns,
+// additional properties or items of another branch, ... ) are left in allOf.
+// A $ref branch is merged as its target, unless recursive or in another
+// document.
+//
+// Contradictions found in merged schemas are returned as conflicts, a merged
+// schema that no value can satisfy being written as {"not": {}}. Bounds that
+// cannot be both satisfied while other types are allowed are replaced by
+// these types.
+func (d *Schema) Simplify() (map[string]interface{}, []SchemaConflict) {
+
+	p := &simplifier{root: referenceLocation(d.rootSchema.ref)}
+	if d.rootSchema.ref != nil {
+		p.document = documentUrl(*d.rootSchema.ref)
+	}
+
+	w := &schemaWriter{inlining: map[string]bool{}, simplifier: p}
+	document := w.document(d.rootSchema, p.root)
+
+	sort.SliceStable(p.conflicts, func(i, j int) bool {
+		return p.conflicts[i].Location < p.conflicts[j].Location
+	})
+
+	return document, p.conflicts
+}
+
+// simplifier merges the allOf of the documents written by a schemaWriter
+type simplifier struct {
+	// root is the location of the root schema, document the URL of its document
+	root      string
+	document  string
+	conflicts []SchemaConflict
+}
+
+// simplifiedBounds are the pairs of bounds checked in merged schemas,
+// with the types they apply to
+var simplifiedBounds = []struct {
+	min   string
+	max   string
+	types []string
+}{
+	{KEY_MINIMUM, KEY_MAXIMUM, []string{TYPE_INTEGER, TYPE_NUMBER}},
+	{KEY_MIN_LENGTH, KEY_MAX_LENGTH, []string{TYPE_STRING}},
+	{KEY_MIN_ITEMS, KEY_MAX_ITEMS, []string{TYPE_ARRAY}},
+	{KEY_MIN_PROPERTIES, KEY_MAX_PROPERTIES, []string{TYPE_OBJECT}},
+}
+
+// inlinable tells if the $ref branch s is merged as its target
+func (p *simplifier) inlinable(s *subSchema, inlining map[string]bool) bool {
+	if s.refSchema == nil || s.resolvedReference == nil {
+		return false
+	}
+	return documentUrl(*s.resolvedReference) == p.document && !inlining[referenceLocation(s.resolvedReference)]
+}
+
+func (p *simplifier) conflict(location string, keyword string, description string, unsatisfiable bool) {
+	p.conflicts = append(p.conflicts, SchemaConflict{
+		Location:      strings.TrimPrefix(location, p.root),
+		Keyword:       keyword,
+		Description:   description,
+		Unsatisfiable: unsatisfiable,
+	})
+}
+
+// simplify merges the allOf branches of m, the document of the schema at location
+func (p *simplifier) simplify(m map[string]interface{}, location string) map[string]interface{} {
+
+	branches, ok := m[KEY_ALL_OF].([]interface{})
+	if !ok {
+		return m
+	}
+	delete(m, KEY_ALL_OF)
+
+	var kept []interface{}
+	for _, branch := range branches {
+		b, _ := branch.(map[string]interface{})
+		if merged, ok := p.merge(m, b, location); ok {
+			m = merged
+		} else {
+			kept = append(kept, branch)
+		}
+	}
+	if len(kept) > 0 {
+		// after the branches left by the merged ones
+		allOf, _ := m[KEY_ALL_OF].([]interface{})
+		m[KEY_ALL_OF] = append(allOf, kept...)
+	}
+
+	return p.check(m, location)
+}
+
+// mergeSchemas returns the document of a value valid against both x and y
+func (p *simplifier) mergeSchemas(x interface{}, y interface{}, location string) interface{} {
+
+	xm, xok := x.(map[string]interface{})
+	ym, yok := y.(map[string]interface{})
+	_, xref := xm[KEY_REF]
+	if !xok || !yok || xref {
+		// keywords next to a $ref are ignored
+		return map[string]interface{}{KEY_ALL_OF: []interface{}{x, y}}
+	}
+
+	m := make(map[string]interface{}, len(xm)+1)
+	for k, v := range xm {
+		m[k] = v
+	}
+	allOf, _ := m[KEY_ALL_OF].([]interface{})
+	m[KEY_ALL_OF] = append(append([]interface{}{}, allOf...), ym)
+
+	return p.simplify(m, location)
+}
+
+// merge returns the keywords of a and b as a single schema, false when
+// some cannot be combined. a is not modified.
+func (p *simplifier) merge(a map[string]interface{}, b map[string]interface{}, location string) (map[string]interface{}, bool) {
+
+	if b == nil {
+		return nil, false
+	}
+	if _, ok := b[KEY_REF]; ok {
+		return nil, false
+	}
+	if _, ok := b[KEY_ID]; ok {
+		// changes the resolution scope of the references of a
+		return nil, false
+	}
+	// additionalProperties depend on the properties of their own schema
+	if hasObjectKeywords(a) && hasObjectKeywords(b) && (hasAdditionalKeywords(a) || hasAdditionalKeywords(b)) {
+		return nil, false
+	}
+	// and additionalItems on the items of their own schema, ignored unless an array
+	if hasTupleItems(a) && hasAdditionalItems(b) || hasAdditionalItems(a) && hasTupleItems(b) {
+		return nil, false
+	}
+
+	merged := make(map[string]interface{}, len(a)+len(b))
+	for k, v := range a {
+		merged[k] = v
+	}
+	mergeBound(merged, b, KEY_MINIMUM, KEY_EXCLUSIVE_MINIMUM, true)
+	mergeBound(merged, b, KEY_MAXIMUM, KEY_EXCLUSIVE_MAXIMUM, false)
+
+	for _, k := range sortedKeys(b) {
+
+		v := b[k]
+		current, exists := merged[k]
+
+		switch k {
+		case KEY_MINIMUM, KEY_EXCLUSIVE_MINIMUM, KEY_MAXIMUM, KEY_EXCLUSIVE_MAXIMUM:
+			continue
+		}
+		if !exists {
+			merged[k] = v
+			continue
+		}
+
+		switch k {
+
+		case KEY_ALL_OF:
+			allOf, _ := current.([]interface{})
+			branches, _ := v.([]interface{})
+			merged[k] = append(append([]interface{}{}, allOf...), branches...)
+
+		case KEY_TYPE:
+			merged[k] = intersectTypes(typesOf(current), typesOf(v))
+
+		case KEY_ENUM:
+			enum, _ := current.([]json.RawMessage)
+			values, _ := v.([]json.RawMessage)
+			intersection := []json.RawMessage{}
+			for _, e := range enum {
+				for _, value := range values {
+					if string(e) == string(value) {
+						intersection = append(intersection, e)
+						break
+					}
+				}
+			}
+			merged[k] = intersection
+
+		case KEY_MIN_LENGTH, KEY_MIN_ITEMS, KEY_MIN_PROPERTIES:
+			if v.(int) > current.(int) {
+				merged[k] = v
+			}
+
+		case KEY_MAX_LENGTH, KEY_MAX_ITEMS, KEY_MAX_PROPERTIES:
+			if v.(int) < current.(int) {
+				merged[k] = v
+			}
+
+		case KEY_MULTIPLE_OF:
+			// kept when one is a multiple of the other
+			x, _ := jsonNumber(current)
+			y, _ := jsonNumber(v)
+			if x > y {
+				x, y = y, x
+				current, v = v, current
+			}
+			if !isFloat64AnInteger(y / x) {
+				return nil, false
+			}
+			merged[k] = v
+
+		case KEY_REQUIRED:
+			required := append([]string{}, current.([]string)...)
+			for _, name := range v.([]string) {
+				if !isStringInSlice(required, name) {
+					required = append(required, name)
+				}
+			}
+			merged[k] = required
+
+		case KEY_PROPERTIES, KEY_DEFINITIONS:
+			children, _ := current.(map[string]interface{})
+			others, _ := v.(map[string]interface{})
+			joined := make(map[string]interface{}, len(children)+len(others))
+			for name, child := range children {
+				joined[name] = child
+			}
+			for _, name := range sortedKeys(others) {
+				child, ok := joined[name]
+				switch {
+				case !ok:
+					joined[name] = others[name]
+				case k == KEY_DEFINITIONS:
+					// referenced by name
+					if !reflect.DeepEqual(child, others[name]) {
+						return nil, false
+					}
+				default:
+					joined[name] = p.mergeSchemas(child, others[name], location+"/"+KEY_PROPERTIES+"/"+jsonPointerTokenEscaper.Replace(name))
+				}
+			}
+			merged[k] = joined
+
+		case KEY_ITEMS:
+			_, currentIsSchema := current.(map[string]interface{})
+			_, isSchema := v.(map[string]interface{})
+			switch {
+			case currentIsSchema && isSchema:
+				merged[k] = p.mergeSchemas(current, v, location+"/"+KEY_ITEMS)
+			case !reflect.DeepEqual(current, v):
+				return nil, false
+			}
+
+		case KEY_UNIQUE_ITEMS, KEY_READ_ONLY, KEY_WRITE_ONLY, KEY_DEPRECATED:
+			// only written when true
+
+		case KEY_SCHEMA, KEY_TITLE, KEY_DESCRIPTION, KEY_EXAMPLES:
+			// annotations of the schema holding the allOf are kept
+
+		default:
+			if !reflect.DeepEqual(current, v) {
+				return nil, false
+			}
+		}
+	}
+
+	return merged, true
+}
+
+// check reports the conflicts of the merged schema m, returning a schema
+// without valid value when m has none
+func (p *simplifier) check(m map[string]interface{}, location string) map[string]interface{} {
+
+	unsatisfiable := false
+
+	types, hasTypes := m[KEY_TYPE]
+	if hasTypes && len(typesOf(types)) == 0 {
+		p.conflict(location, KEY_TYPE, formatErrorDescription(Locale.EmptyIntersection(), ErrorDetails{"keyword": KEY_TYPE}), true)
+		unsatisfiable = true
+	}
+	if enum, ok := m[KEY_ENUM].([]json.RawMessage); ok && len(enum) == 0 {
+		p.conflict(location, KEY_ENUM, formatErrorDescription(Locale.EmptyIntersection(), ErrorDetails{"keyword": KEY_ENUM}), true)
+		unsatisfiable = true
+	}
+
+	for _, bounds := range simplifiedBounds {
+		min, hasMin := jsonNumber(m[bounds.min])
+		max, hasMax := jsonNumber(m[bounds.max])
+		if !hasMin || !hasMax {
+			continue
+		}
+		exclusive := bounds.min == KEY_MINIMUM && (m[KEY_EXCLUSIVE_MINIMUM] == true || m[KEY_EXCLUSIVE_MAXIMUM] == true)
+		if min < max || min == max && !exclusive {
+			continue
+		}
+
+		// values of the other types are still valid
+		typesBounded := hasTypes
+		for _, t := range typesOf(types) {
+			if !isStringInSlice(bounds.types, t) {
+				typesBounded = false
+			}
+		}
+		p.conflict(location, bounds.min, formatErrorDescription(Locale.ConflictingKeywords(), ErrorDetails{"keyword": bounds.min, "other": bounds.max}), typesBounded)
+		if typesBounded {
+			unsatisfiable = true
+			continue
+		}
+
+		// the bounds never apply to a valid value : they are replaced by the
+		// types they do not bound, as the compiler rejects min above max
+		allowed := JSON_TYPES
+		if hasTypes {
+			allowed = typesOf(types)
+		}
+		var unbounded []string
+		for _, t := range JSON_TYPES {
+			if !isStringInSlice(bounds.types, t) {
+				unbounded = append(unbounded, t)
+			}
+		}
+		types = intersectTypes(allowed, unbounded)
+		hasTypes = true
+		m[KEY_TYPE] = types
+		delete(m, bounds.min)
+		delete(m, bounds.max)
+		if bounds.min == KEY_MINIMUM {
+			delete(m, KEY_EXCLUSIVE_MINIMUM)
+			delete(m, KEY_EXCLUSIVE_MAXIMUM)
+		}
+	}
+
+	if unsatisfiable {
+		return map[string]interface{}{KEY_NOT: map[string]interface{}{}}
+	}
+
+	return m
+}
+
+// mergeBound keeps in merged the tighter of its bound and the one of b
+func mergeBound(merged map[string]interface{}, b map[string]interface{}, key string, exclusiveKey string, lower bool) {
+
+	bound, ok := jsonNumber(b[key])
+	if !ok {
+		return
+	}
+	exclusive := b[exclusiveKey] == true
+
+	current, ok := jsonNumber(merged[key])
+	switch {
+	case !ok, lower && bound > current, !lower && bound < current:
+	case bound == current:
+		exclusive = exclusive || merged[exclusiveKey] == true
+	default:
+		return
+	}
+
+	merged[key] = b[key]
+	if exclusive {
+		merged[exclusiveKey] = true
+	} else {
+		delete(merged, exclusiveKey)
+	}
+}
+
+// typesOf returns the types of a type keyword as written by a schemaWriter
+func typesOf(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	}
+	return nil
+}
+
+// intersectTypes returns the type keyword of the types in both a and b,
+// integer being a subset of number
+func intersectTypes(a []string, b []string) interface{} {
+
+	types := []string{}
+	if len(a) == 0 || len(b) == 0 {
+		return types
+	}
+	for _, t := range JSON_TYPES {
+		if acceptsType(a, t) && acceptsType(b, t) {
+			types = append(types, t)
+		}
+	}
+	if isStringInSlice(types, TYPE_NUMBER) {
+		// implied by number
+		for i, t := range types {
+			if t == TYPE_INTEGER {
+				types = append(types[:i], types[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if len(types) == 1 {
+		return types[0]
+	}
+	return types
+}
+
+func hasObjectKeywords(m map[string]interface{}) bool {
+	_, properties := m[KEY_PROPERTIES]
+	return properties || hasAdditionalKeywords(m)
+}
+
+func hasAdditionalKeywords(m map[string]interface{}) bool {
+	_, patternProperties := m[KEY_PATTERN_PROPERTIES]
+	_, additionalProperties := m[KEY_ADDITIONAL_PROPERTIES]
+	return patternProperties || additionalProperties
+}
+
+func hasTupleItems(m map[string]interface{}) bool {
+	_, tuple := m[KEY_ITEMS].([]interface{})
+	return tuple
+}
+
+func hasAdditionalItems(m map[string]interface{}) bool {
+	_, additionalItems := m[KEY_ADDITIONAL_ITEMS]
+	return additionalItems
+}
+
+// jsonNumber returns a number as written by a schemaWriter
+func jsonNumber(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int:
+		return float64(value), true
+	case float64:
+		return value, true
+	case json.Number:
+		f, err := value.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
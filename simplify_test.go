@@ -0,0 +1,133 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the merging of allOf branches.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func simplified(t *testing.T, schema string) (string, []SchemaConflict) {
+	s, err := NewSchema(NewStringLoader(schema))
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	document, conflicts := s.Simplify()
+	b, err := json.Marshal(document)
+	assert.Nil(t, err)
+	return string(b), conflicts
+}
+
+func TestSimplify(t *testing.T) {
+
+	document, conflicts := simplified(t, `{
+		"definitions": {
+			"named": {"properties": {"name": {"type": "string", "maxLength": 20}}, "required": ["name"]}
+		},
+		"type": ["object", "null"],
+		"allOf": [
+			{"$ref": "#/definitions/named"},
+			{"type": "object", "properties": {"name": {"minLength": 1, "maxLength": 10}, "age": {"type": "integer"}}, "required": ["age"]},
+			{"properties": {"age": {"type": "number", "minimum": 0, "maximum": 150}}},
+			{"properties": {"age": {"minimum": 0, "exclusiveMinimum": true}}},
+			{"enum": [{"name": "a", "age": 1}, {"name": "b", "age": 2}]},
+			{"enum": [{"age": 2, "name": "b"}]}
+		]
+	}`)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, `{"definitions":{"named":{"properties":{"name":{"maxLength":20,"type":"string"}},"required":["name"]}},`+
+		`"enum":[{"age":2,"name":"b"}],`+
+		`"properties":{"age":{"exclusiveMinimum":true,"maximum":150,"minimum":0,"type":"integer"},"name":{"maxLength":10,"minLength":1,"type":"string"}},`+
+		`"required":["name","age"],"type":"object"}`, document)
+
+	// kept in allOf when not combinable
+	document, conflicts = simplified(t, `{
+		"allOf": [
+			{"properties": {"a": {}}, "additionalProperties": false},
+			{"properties": {"b": {}}},
+			{"pattern": "^a"},
+			{"pattern": "b$"},
+			{"multipleOf": 2},
+			{"multipleOf": 4}
+		]
+	}`)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, `{"additionalProperties":false,"allOf":[{"properties":{"b":{}}},{"pattern":"b$"}],"multipleOf":4,"pattern":"^a","properties":{"a":{}}}`, document)
+
+	// additionalItems apply next to the items of their own schema
+	document, conflicts = simplified(t, `{"items": [{}], "allOf": [{"additionalItems": false}, {"items": [{}]}]}`)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, `{"allOf":[{"additionalItems":false}],"items":[{}]}`, document)
+	schema, err := NewSchema(NewStringLoader(document))
+	if assert.Nil(t, err) {
+		result, err := schema.Validate(NewStringLoader(`[1, 2]`))
+		assert.Nil(t, err)
+		assert.True(t, result.Valid())
+	}
+
+	// recursive references are not merged
+	document, conflicts = simplified(t, `{"allOf": [{"$ref": "#"}], "type": "object"}`)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, `{"allOf":[{"$ref":"#"}],"type":"object"}`, document)
+}
+
+func TestSimplifyConflicts(t *testing.T) {
+
+	document, conflicts := simplified(t, `{
+		"properties": {
+			"id": {"allOf": [{"type": "string"}, {"type": "integer"}]},
+			"code": {"allOf": [{"type": "string", "minLength": 5}, {"maxLength": 3}]},
+			"any": {"allOf": [{"minLength": 5}, {"maxLength": 3}]},
+			"text": {"allOf": [{"type": "string", "minimum": 5}, {"maximum": 3}]},
+			"size": {"allOf": [{"type": "integer", "minimum": 3}, {"maximum": 3, "exclusiveMaximum": true}]},
+			"tag": {"allOf": [{"enum": ["a", "b"]}, {"enum": ["c"]}]}
+		}
+	}`)
+	// the bounds of the types still valid are replaced by these types
+	assert.Equal(t, `{"properties":{"any":{"type":["array","boolean","number","null","object"]},"code":{"not":{}},"id":{"not":{}},"size":{"not":{}},"tag":{"not":{}},"text":{"type":"string"}}}`, document)
+	assert.Equal(t, []SchemaConflict{
+		{Location: "/properties/any", Keyword: KEY_MIN_LENGTH, Description: "minLength and maxLength cannot be both satisfied"},
+		{Location: "/properties/code", Keyword: KEY_MIN_LENGTH, Description: "minLength and maxLength cannot be both satisfied", Unsatisfiable: true},
+		{Location: "/properties/id", Keyword: KEY_TYPE, Description: "No value is allowed by every type", Unsatisfiable: true},
+		{Location: "/properties/size", Keyword: KEY_MINIMUM, Description: "minimum and maximum cannot be both satisfied", Unsatisfiable: true},
+		{Location: "/properties/tag", Keyword: KEY_ENUM, Description: "No value is allowed by every enum", Unsatisfiable: true},
+		{Location: "/properties/text", Keyword: KEY_MINIMUM, Description: "minimum and maximum cannot be both satisfied"},
+	}, conflicts)
+
+	// the output compiles and accepts the same values
+	schema, err := NewSchema(NewStringLoader(document))
+	if !assert.Nil(t, err) {
+		return
+	}
+	for instance, valid := range map[string]bool{`{"any": "abcd"}`: false, `{"any": 4}`: true, `{"text": "a"}`: true, `{"text": 4}`: false} {
+		result, err := schema.Validate(NewStringLoader(instance))
+		if assert.Nil(t, err) {
+			assert.Equal(t, valid, result.Valid(), instance)
+		}
+	}
+}
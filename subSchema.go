@@ -27,8 +27,8 @@
 package gojsonschema
 
 import (
+	"encoding/json"
 	"errors"
-	"regexp"
 	"strings"
 
 	"github.com/xeipuuv/gojsonreference"
@@ -68,6 +68,12 @@ const (
 	KEY_ANY_OF                = "anyOf"
 	KEY_ALL_OF                = "allOf"
 	KEY_NOT                   = "not"
+
+	// annotations of later drafts
+	KEY_EXAMPLES   = "examples"
+	KEY_READ_ONLY  = "readOnly"
+	KEY_WRITE_ONLY = "writeOnly"
+	KEY_DEPRECATED = "deprecated"
 )
 
 type subSchema struct {
@@ -77,6 +83,12 @@ type subSchema struct {
 	title       *string
 	description *string
 
+	// annotations
+	examples   []interface{}
+	readOnly   bool
+	writeOnly  bool
+	deprecated bool
+
 	property string
 
 	// Types associated with the subSchema
@@ -84,6 +96,9 @@ type subSchema struct {
 
 	// Reference url
 	ref *gojsonreference.JsonReference
+	// $ref as written in the document, and resolved against the base
+	reference         string
+	resolvedReference *gojsonreference.JsonReference
 	// Schema referenced
 	refSchema *subSchema
 	// Json reference
@@ -103,11 +118,13 @@ type subSchema struct {
 	exclusiveMaximum bool
 	minimum          *float64
 	exclusiveMinimum bool
+	// multipleOf, minimum and maximum as written
+	numbers map[string]json.Number
 
 	// validation : string
 	minLength *int
 	maxLength *int
-	pattern   *regexp.Regexp
+	pattern   Regexp
 	format    string
 
 	// validation : object
@@ -118,6 +135,8 @@ type subSchema struct {
 	dependencies         map[string]interface{}
 	additionalProperties interface{}
 	patternProperties    map[string]*subSchema
+	// compiled keys of patternProperties
+	patternPropertiesRegexps map[string]Regexp
 
 	// validation : array
 	minItems    *int
@@ -134,6 +153,10 @@ type subSchema struct {
 	anyOf []*subSchema
 	allOf []*subSchema
 	not   *subSchema
+
+	// validation : custom keywords
+	customKeywords      []KeywordValidator
+	customKeywordValues map[string]interface{}
 }
 
 func (s *subSchema) AddEnum(i interface{}) error {
@@ -165,6 +188,20 @@ func (s *subSchema) ContainsEnum(i interface{}) (bool, error) {
 	return isStringInSlice(s.enum, *is), nil
 }
 
+// enumValues returns the values of enum decoded, numbers being json.Number
+func (s *subSchema) enumValues() []interface{} {
+	if len(s.enum) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(s.enum))
+	for _, e := range s.enum {
+		if value, err := decodeJsonUsingNumber(strings.NewReader(e)); err == nil {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func (s *subSchema) AddOneOf(subSchema *subSchema) {
 	s.oneOf = append(s.oneOf, subSchema)
 }
@@ -195,6 +232,24 @@ func (s *subSchema) AddRequired(value string) error {
 	return nil
 }
 
+// keepNumber keeps the number of keyword as written, float64 losing precision
+func (s *subSchema) keepNumber(keyword string, value interface{}) {
+	if number, ok := value.(json.Number); ok {
+		if s.numbers == nil {
+			s.numbers = make(map[string]json.Number)
+		}
+		s.numbers[keyword] = number
+	}
+}
+
+// number returns the number of keyword as written, or value when unknown
+func (s *subSchema) number(keyword string, value float64) interface{} {
+	if number, ok := s.numbers[keyword]; ok {
+		return number
+	}
+	return value
+}
+
 func (s *subSchema) AddDefinitionChild(child *subSchema) {
 	s.definitionsChildren = append(s.definitionsChildren, child)
 }
@@ -225,3 +280,11 @@ func (s *subSchema) PatternPropertiesString() string {
 	return "[" + strings.Join(patternPropertiesKeySlice, ",") + "]"
 
 }
+
+// resolvedSchema follows $ref links to the schema that actually holds the keywords
+func resolvedSchema(s *subSchema) *subSchema {
+	for i := 0; s.refSchema != nil && i < 64; i++ {
+		s = s.refSchema
+	}
+	return s
+}
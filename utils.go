@@ -29,6 +29,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -63,6 +64,48 @@ func marshalToJsonString(value interface{}) (*string, error) {
 	return &sBytes, nil
 }
 
+// jsonEqual reports whether two decoded JSON values are equal, numbers being
+// compared by value so that 1 and 1.0 are equal
+func jsonEqual(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		n, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, okX := new(big.Rat).SetString(a.String())
+		y, okY := new(big.Rat).SetString(n.String())
+		if !okX || !okY {
+			return a == n
+		}
+		return x.Cmp(y) == 0
+	case []interface{}:
+		values, ok := b.([]interface{})
+		if !ok || len(values) != len(a) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], values[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		m, ok := b.(map[string]interface{})
+		if !ok || len(m) != len(a) {
+			return false
+		}
+		for k, v := range a {
+			other, ok := m[k]
+			if !ok || !jsonEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 func isJsonNumber(what interface{}) bool {
 
 	switch what.(type) {
@@ -94,6 +137,29 @@ func checkJsonNumber(what interface{}) (isValidFloat64 bool, isValidInt64 bool,
 
 }
 
+// jsonType returns the JSON Schema type of a decoded value, numbers being
+// json.Number, integers as validation tells them from numbers
+func jsonType(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return TYPE_NULL
+	case bool:
+		return TYPE_BOOLEAN
+	case json.Number:
+		if _, isValidInt64, _ := checkJsonNumber(value); isValidInt64 {
+			return TYPE_INTEGER
+		}
+		return TYPE_NUMBER
+	case string:
+		return TYPE_STRING
+	case []interface{}:
+		return TYPE_ARRAY
+	case map[string]interface{}:
+		return TYPE_OBJECT
+	}
+	return ""
+}
+
 // same as ECMA Number.MAX_SAFE_INTEGER and Number.MIN_SAFE_INTEGER
 const (
 	max_json_float = float64(1<<53 - 1)  // 9007199254740991.0 	 2^53 - 1
@@ -0,0 +1,216 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      Validation of a fragment of a document against a subschema.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonpointer"
+)
+
+// ValidateAt validates a document against the subschema at schemaPointer,
+// ie #/definitions/PodTemplate, without compiling it again.
+// The empty pointer and # are the root schema.
+func (v *Schema) ValidateAt(schemaPointer string, l JSONLoader) (*Result, error) {
+	return v.ValidateFragment(context.Background(), schemaPointer, "", l)
+}
+
+// ValidatePointer validates the value at instancePointer of a document, ie
+// /spec/template, against the subschemas applying at that location : the
+// path is followed from the root schema through properties,
+// patternProperties, additionalProperties, items, additionalItems, allOf and
+// $ref. anyOf, oneOf, not and dependencies are not followed, whether they
+// apply depending on the rest of the document. A value no subschema applies
+// to is valid. The errors keep their path from the root of the document.
+func (v *Schema) ValidatePointer(instancePointer string, l JSONLoader) (*Result, error) {
+
+	root, err := l.LoadJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	pointer, err := gojsonpointer.NewJsonPointer(instancePointer)
+	if err != nil {
+		return nil, err
+	}
+	fragment, _, err := pointer.Get(root)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := []*subSchema{v.rootSchema}
+	value := root
+	if instancePointer != "" {
+		for _, token := range strings.Split(instancePointer[1:], "/") {
+			token = jsonPointerTokenUnescaper.Replace(token)
+			schemas = childSchemas(schemas, token, value)
+			value = childValue(value, token)
+		}
+	}
+
+	state := v.newValidationState(context.Background(), root)
+	result := &Result{state: state}
+	location := jsonContextOf(instancePointer)
+	for _, s := range schemas {
+		validationResult := s.subValidateWithContext(fragment, location, state)
+		result.mergeErrors(validationResult)
+		result.mergeAnnotations(validationResult)
+	}
+
+	return result, nil
+}
+
+// childSchemas returns the schemas applying to the child token of value, when
+// schemas apply to value
+func childSchemas(schemas []*subSchema, token string, value interface{}) []*subSchema {
+
+	var children []*subSchema
+	seen := map[*subSchema]bool{}
+	add := func(child *subSchema) {
+		if !seen[child] {
+			seen[child] = true
+			children = append(children, child)
+		}
+	}
+
+	for _, s := range appliedSchemas(schemas) {
+		switch value.(type) {
+		case map[string]interface{}:
+			for _, child := range s.propertySchemas(token) {
+				add(child)
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || s.itemsChildren == nil {
+				continue
+			}
+			for _, child := range itemSchemas(s, index) {
+				add(child)
+			}
+		}
+	}
+
+	return children
+}
+
+// childValue returns the child token of an object or an array, nil when there is none
+func childValue(value interface{}, token string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return value[token]
+	case []interface{}:
+		if index, err := strconv.Atoi(token); err == nil && index >= 0 && index < len(value) {
+			return value[index]
+		}
+	}
+	return nil
+}
+
+// appliedSchemas returns the schemas holding the keywords of schemas, their
+// $ref and allOf being followed
+func appliedSchemas(schemas []*subSchema) []*subSchema {
+
+	var applied []*subSchema
+	seen := map[*subSchema]bool{}
+
+	var walk func(s *subSchema)
+	walk = func(s *subSchema) {
+		s = resolvedSchema(s)
+		if seen[s] {
+			return
+		}
+		seen[s] = true
+		applied = append(applied, s)
+		for _, branch := range s.allOf {
+			walk(branch)
+		}
+	}
+	for _, s := range schemas {
+		walk(s)
+	}
+
+	return applied
+}
+
+// ValidateFragment validates the value at instancePointer of a document against
+// the subschema at schemaPointer, ctx is given to the ContextFormatCheckers
+func (v *Schema) ValidateFragment(ctx context.Context, schemaPointer string, instancePointer string, l JSONLoader) (*Result, error) {
+
+	schema, err := v.subSchemaAt(schemaPointer)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := l.LoadJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	pointer, err := gojsonpointer.NewJsonPointer(instancePointer)
+	if err != nil {
+		return nil, err
+	}
+	fragment, _, err := pointer.Get(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.subValidateWithContext(fragment, jsonContextOf(instancePointer), v.newValidationState(ctx, root)), nil
+}
+
+// subSchemaAt returns the subschema at a pointer of the schema document,
+// from the reference pool when a $ref targets it, parsed and added to the
+// pool otherwise
+func (v *Schema) subSchemaAt(schemaPointer string) (*subSchema, error) {
+
+	if schemaPointer == "" || schemaPointer == "#" {
+		return v.rootSchema, nil
+	}
+	if !strings.HasPrefix(schemaPointer, "#") {
+		schemaPointer = "#" + schemaPointer
+	}
+
+	reference, err := v.resolveReference(v.rootSchema, schemaPointer)
+	if err != nil {
+		return nil, err
+	}
+
+	v.referencesMutex.Lock()
+	defer v.referencesMutex.Unlock()
+
+	if s, ok := v.referencePool.Get(reference.String()); ok {
+		return s, nil
+	}
+
+	s := &subSchema{property: STRING_ROOT_SCHEMA_PROPERTY, ref: v.rootSchema.ref}
+	if err := v.parseReference(nil, s, schemaPointer); err != nil {
+		return nil, err
+	}
+
+	return s.refSchema, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for the validation of fragments.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFragment(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"definitions": {
+			"PodTemplate": {
+				"type": "object",
+				"properties": {"name": {"type": "string"}, "containers": {"type": "array", "items": {"$ref": "#/definitions/Container"}}},
+				"required": ["name"]
+			},
+			"Container": {"type": "object", "properties": {"image": {"type": "string"}}, "required": ["image"]}
+		},
+		"type": "object",
+		"properties": {"spec": {"properties": {"template": {"$ref": "#/definitions/PodTemplate"}}}},
+		"required": ["kind"]
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	document := NewStringLoader(`{"spec": {"template": {"name": 1, "containers": [{"image": "a"}, {}]}, "a/b": {"~c": {"name": "x"}}}}`)
+
+	errorPaths := func(result *Result, err error) []string {
+		if !assert.Nil(t, err) {
+			return nil
+		}
+		paths := []string{}
+		for _, e := range result.Errors() {
+			paths = append(paths, e.Type()+" "+e.Context().JsonPointer())
+		}
+		return paths
+	}
+
+	// the whole document against a subschema
+	assert.Equal(t, []string{"required "}, errorPaths(schema.ValidateAt("#/definitions/PodTemplate", document)))
+	assert.Equal(t, []string{"required "}, errorPaths(schema.ValidateAt("/definitions/Container", document)))
+
+	// a fragment against the subschemas applying at its location
+	assert.ElementsMatch(t, []string{"invalid_type /spec/template/name", "required /spec/template/containers/1"},
+		errorPaths(schema.ValidatePointer("/spec/template", document)))
+	assert.Equal(t, []string{"required /spec/template/containers/1"},
+		errorPaths(schema.ValidatePointer("/spec/template/containers/1", document)))
+	assert.Equal(t, []string{}, errorPaths(schema.ValidatePointer("/spec/a~1b/~0c", document)))
+
+	// a fragment against a subschema, both referenced and not
+	assert.ElementsMatch(t, []string{"invalid_type /spec/template/name", "required /spec/template/containers/1"},
+		errorPaths(schema.ValidateFragment(context.Background(), "#/definitions/PodTemplate", "/spec/template", document)))
+	assert.Equal(t, []string{"required /spec/template/containers/1"},
+		errorPaths(schema.ValidateFragment(context.Background(), "#/definitions/Container", "/spec/template/containers/1", document)))
+	assert.Equal(t, []string{},
+		errorPaths(schema.ValidateFragment(context.Background(), "#/definitions/PodTemplate", "/spec/a~1b/~0c", document)))
+
+	assert.Equal(t, []string{}, errorPaths(schema.ValidateAt("#", NewStringLoader(`{"kind": "Pod"}`))))
+
+	_, err = schema.ValidateAt("#/definitions/Missing", document)
+	assert.NotNil(t, err)
+	_, err = schema.ValidatePointer("/spec/missing", document)
+	assert.NotNil(t, err)
+}
+
+func TestValidatePointerSubschemas(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {"x": {"type": "integer"}},
+		"patternProperties": {"^x": {"minimum": 0}},
+		"additionalProperties": {"type": "string"},
+		"allOf": [{"properties": {"list": {"items": [{"type": "boolean"}], "additionalItems": {"type": "null"}}}}]
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	errorTypes := func(pointer string, document string) []string {
+		result, err := schema.ValidatePointer(pointer, NewStringLoader(document))
+		if !assert.Nil(t, err) {
+			return nil
+		}
+		types := []string{}
+		for _, e := range result.Errors() {
+			types = append(types, e.Type())
+		}
+		return types
+	}
+
+	assert.Equal(t, []string{"invalid_type"}, errorTypes("/x", `{"x": "s"}`))
+	assert.Equal(t, []string{"number_gte"}, errorTypes("/x", `{"x": -1}`))
+	assert.Equal(t, []string{"invalid_type"}, errorTypes("/other", `{"other": 1}`))
+	assert.Equal(t, []string{"invalid_type"}, errorTypes("/list/0", `{"list": [1, null]}`))
+	assert.Equal(t, []string{}, errorTypes("/list/1", `{"list": [true, null]}`))
+	assert.Equal(t, []string{"invalid_type"}, errorTypes("/list/1", `{"list": [true, 1]}`))
+}
@@ -26,9 +26,9 @@
 package gojsonschema
 
 import (
+	"context"
 	"encoding/json"
 	"reflect"
-	"regexp"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -52,7 +52,30 @@ func Validate(ls JSONLoader, ld JSONLoader) (*Result, error) {
 
 }
 
+// Direction is the direction of an API call a document is validated for
+type Direction int
+
+const (
+	// DIRECTION_NONE ignores readOnly and writeOnly
+	DIRECTION_NONE Direction = iota
+	// DIRECTION_REQUEST rejects readOnly properties
+	DIRECTION_REQUEST
+	// DIRECTION_RESPONSE rejects writeOnly properties
+	DIRECTION_RESPONSE
+)
+
 func (v *Schema) Validate(l JSONLoader) (*Result, error) {
+	return v.ValidateContext(context.Background(), l)
+}
+
+// ValidateContext validates a document, ctx is given to the ContextFormatCheckers
+func (v *Schema) ValidateContext(ctx context.Context, l JSONLoader) (*Result, error) {
+	return v.ValidateDirection(ctx, l, DIRECTION_NONE)
+}
+
+// ValidateDirection validates a document sent in the given direction :
+// readOnly properties are errors in a request, writeOnly properties in a response
+func (v *Schema) ValidateDirection(ctx context.Context, l JSONLoader, direction Direction) (*Result, error) {
 
 	// load document
 
@@ -63,28 +86,68 @@ func (v *Schema) Validate(l JSONLoader) (*Result, error) {
 
 	// begin validation
 
-	result := &Result{}
-	context := newJsonContext(STRING_CONTEXT_ROOT, nil)
-	v.rootSchema.validateRecursive(v.rootSchema, root, result, context)
+	state := v.newValidationState(ctx, root)
+	state.direction = direction
+
+	return v.validateWithState(state), nil
 
-	return result, nil
+}
 
+// validationState holds what is shared by all the results of a validation
+type validationState struct {
+	ctx             context.Context
+	root            interface{}
+	formatAssertion bool
+	annotations     bool
+	unknownFormats  UnknownFormatMode
+	regexpEngine    RegexpEngine
+	direction       Direction
 }
 
-func (v *subSchema) subValidateWithContext(document interface{}, context *jsonContext) *Result {
-	result := &Result{}
+func (v *Schema) newValidationState(ctx context.Context, root interface{}) *validationState {
+	return &validationState{
+		ctx:             ctx,
+		root:            root,
+		formatAssertion: v.formatAssertion,
+		annotations:     v.annotations,
+		unknownFormats:  v.unknownFormats,
+		regexpEngine:    v.regexpEngine,
+	}
+}
+
+func (v *Schema) validateDocument(ctx context.Context, root interface{}) *Result {
+	return v.validateWithState(v.newValidationState(ctx, root))
+}
+
+func (v *Schema) validateWithState(state *validationState) *Result {
+	result := &Result{state: state}
+	context := NewJsonContext(STRING_CONTEXT_ROOT, nil)
+	v.rootSchema.validateRecursive(v.rootSchema, state.root, result, context)
+	return result
+}
+
+func (v *subSchema) subValidateWithContext(document interface{}, context *JsonContext, state *validationState) *Result {
+	result := &Result{state: state}
 	v.validateRecursive(v, document, result, context)
 	return result
 }
 
 // Walker function to validate the json recursively against the subSchema
-func (v *subSchema) validateRecursive(currentSubSchema *subSchema, currentNode interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateRecursive(currentSubSchema *subSchema, currentNode interface{}, result *Result, context *JsonContext) {
 
 	if internalLogEnabled {
 		internalLog("validateRecursive %s", context.String())
 		internalLog(" %v", currentNode)
 	}
 
+	// annotations are only kept from valid schemas
+	nbErrors, nbAnnotations := len(result.errors), len(result.annotations)
+	defer func() {
+		if len(result.errors) > nbErrors {
+			result.annotations = result.annotations[:nbAnnotations]
+		}
+	}()
+
 	// Handle referenced schemas, returns directly when a $ref is found
 	if currentSubSchema.refSchema != nil {
 		v.validateRecursive(currentSubSchema.refSchema, currentNode, result, context)
@@ -203,7 +266,7 @@ func (v *subSchema) validateRecursive(currentSubSchema *subSchema, currentNode i
 				for _, pSchema := range currentSubSchema.propertiesChildren {
 					nextNode, ok := castCurrentNode[pSchema.property]
 					if ok {
-						subContext := newJsonContext(pSchema.property, context)
+						subContext := NewJsonContext(pSchema.property, context)
 						v.validateRecursive(pSchema, nextNode, result, subContext)
 					}
 				}
@@ -264,7 +327,7 @@ func (v *subSchema) validateRecursive(currentSubSchema *subSchema, currentNode i
 }
 
 // Different kinds of validation there, subSchema / common / array / object / string...
-func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode interface{}, result *Result, context *JsonContext) {
 
 	if internalLogEnabled {
 		internalLog("validateSchema %s", context.String())
@@ -277,13 +340,18 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 		var bestValidationResult *Result
 
 		for _, anyOfSchema := range currentSubSchema.anyOf {
-			if !validatedAnyOf {
-				validationResult := anyOfSchema.subValidateWithContext(currentNode, context)
-				validatedAnyOf = validationResult.Valid()
+			// the branches following a valid one only add annotations
+			if validatedAnyOf && !result.state.annotations {
+				break
+			}
 
-				if !validatedAnyOf && (bestValidationResult == nil || validationResult.score > bestValidationResult.score) {
-					bestValidationResult = validationResult
-				}
+			validationResult := anyOfSchema.subValidateWithContext(currentNode, context, result.state)
+
+			if validationResult.Valid() {
+				validatedAnyOf = true
+				result.mergeAnnotations(validationResult)
+			} else if !validatedAnyOf && (bestValidationResult == nil || validationResult.score > bestValidationResult.score) {
+				bestValidationResult = validationResult
 			}
 		}
 		if !validatedAnyOf {
@@ -301,12 +369,13 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 	if len(currentSubSchema.oneOf) > 0 {
 
 		nbValidated := 0
-		var bestValidationResult *Result
+		var bestValidationResult, validResult *Result
 
 		for _, oneOfSchema := range currentSubSchema.oneOf {
-			validationResult := oneOfSchema.subValidateWithContext(currentNode, context)
+			validationResult := oneOfSchema.subValidateWithContext(currentNode, context, result.state)
 			if validationResult.Valid() {
 				nbValidated++
+				validResult = validationResult
 			} else if nbValidated == 0 && (bestValidationResult == nil || validationResult.score > bestValidationResult.score) {
 				bestValidationResult = validationResult
 			}
@@ -321,6 +390,8 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 				// that's probably the one the user was trying to match
 				result.mergeErrors(bestValidationResult)
 			}
+		} else {
+			result.mergeAnnotations(validResult)
 		}
 
 	}
@@ -329,7 +400,7 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 		nbValidated := 0
 
 		for _, allOfSchema := range currentSubSchema.allOf {
-			validationResult := allOfSchema.subValidateWithContext(currentNode, context)
+			validationResult := allOfSchema.subValidateWithContext(currentNode, context, result.state)
 			if validationResult.Valid() {
 				nbValidated++
 			}
@@ -342,7 +413,7 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 	}
 
 	if currentSubSchema.not != nil {
-		validationResult := currentSubSchema.not.subValidateWithContext(currentNode, context)
+		validationResult := currentSubSchema.not.subValidateWithContext(currentNode, context, result.state)
 		if validationResult.Valid() {
 			result.addError(new(NumberNotError), context, currentNode, ErrorDetails{})
 		}
@@ -378,7 +449,7 @@ func (v *subSchema) validateSchema(currentSubSchema *subSchema, currentNode inte
 	result.incrementScore()
 }
 
-func (v *subSchema) validateCommon(currentSubSchema *subSchema, value interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateCommon(currentSubSchema *subSchema, value interface{}, result *Result, context *JsonContext) {
 
 	if internalLogEnabled {
 		internalLog("validateCommon %s", context.String())
@@ -403,10 +474,68 @@ func (v *subSchema) validateCommon(currentSubSchema *subSchema, value interface{
 		}
 	}
 
+	// format:
+	if currentSubSchema.format != "" {
+		if !result.state.formatAssertion {
+			result.addAnnotation(context, KEY_FORMAT, currentSubSchema.format)
+		} else if FormatCheckers.Has(currentSubSchema.format) {
+			input := FormatInput{
+				Value:        value,
+				Location:     context.JsonPointer(),
+				Root:         result.state.root,
+				regexpEngine: result.state.regexpEngine,
+			}
+			if err := FormatCheckers.checkFormat(result.state.ctx, currentSubSchema.format, input); err != nil {
+				details := ErrorDetails{"format": currentSubSchema.format}
+				if err != errDoesNotMatchFormat {
+					details["error"] = err
+				}
+				result.addError(
+					new(DoesNotMatchFormatError),
+					context,
+					value,
+					details,
+				)
+			}
+		} else if result.state.unknownFormats != UNKNOWN_FORMAT_IGNORE {
+			result.addError(
+				new(DoesNotMatchFormatError),
+				context,
+				value,
+				ErrorDetails{"format": currentSubSchema.format},
+			)
+		}
+	}
+
+	// annotations:
+	if currentSubSchema.title != nil {
+		result.addAnnotation(context, KEY_TITLE, *currentSubSchema.title)
+	}
+	if currentSubSchema.description != nil {
+		result.addAnnotation(context, KEY_DESCRIPTION, *currentSubSchema.description)
+	}
+	if currentSubSchema.examples != nil {
+		result.addAnnotation(context, KEY_EXAMPLES, currentSubSchema.examples)
+	}
+	if currentSubSchema.readOnly {
+		result.addAnnotation(context, KEY_READ_ONLY, true)
+	}
+	if currentSubSchema.writeOnly {
+		result.addAnnotation(context, KEY_WRITE_ONLY, true)
+	}
+	if currentSubSchema.deprecated {
+		result.addAnnotation(context, KEY_DEPRECATED, true)
+	}
+
+	// custom keywords:
+	for _, validator := range currentSubSchema.customKeywords {
+		validator.Validate(value, result, context)
+	}
+
 	result.incrementScore()
 }
 
-func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface{}, result *Result, context *JsonContext) {
 
 	if internalLogEnabled {
 		internalLog("validateArray %s", context.String())
@@ -418,8 +547,8 @@ func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface
 	// TODO explain
 	if currentSubSchema.itemsChildrenIsSingleSchema {
 		for i := range value {
-			subContext := newJsonContext(strconv.Itoa(i), context)
-			validationResult := currentSubSchema.itemsChildren[0].subValidateWithContext(value[i], subContext)
+			subContext := NewJsonContext(strconv.Itoa(i), context)
+			validationResult := currentSubSchema.itemsChildren[0].subValidateWithContext(value[i], subContext, result.state)
 			result.mergeErrors(validationResult)
 		}
 	} else {
@@ -430,8 +559,8 @@ func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface
 
 			if nbItems == nbValues {
 				for i := 0; i != nbItems; i++ {
-					subContext := newJsonContext(strconv.Itoa(i), context)
-					validationResult := currentSubSchema.itemsChildren[i].subValidateWithContext(value[i], subContext)
+					subContext := NewJsonContext(strconv.Itoa(i), context)
+					validationResult := currentSubSchema.itemsChildren[i].subValidateWithContext(value[i], subContext, result.state)
 					result.mergeErrors(validationResult)
 				}
 			} else if nbItems < nbValues {
@@ -443,8 +572,8 @@ func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface
 				case *subSchema:
 					additionalItemSchema := currentSubSchema.additionalItems.(*subSchema)
 					for i := nbItems; i != nbValues; i++ {
-						subContext := newJsonContext(strconv.Itoa(i), context)
-						validationResult := additionalItemSchema.subValidateWithContext(value[i], subContext)
+						subContext := NewJsonContext(strconv.Itoa(i), context)
+						validationResult := additionalItemSchema.subValidateWithContext(value[i], subContext, result.state)
 						result.mergeErrors(validationResult)
 					}
 				}
@@ -497,7 +626,7 @@ func (v *subSchema) validateArray(currentSubSchema *subSchema, value []interface
 	result.incrementScore()
 }
 
-func (v *subSchema) validateObject(currentSubSchema *subSchema, value map[string]interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateObject(currentSubSchema *subSchema, value map[string]interface{}, result *Result, context *JsonContext) {
 
 	if internalLogEnabled {
 		internalLog("validateObject %s", context.String())
@@ -541,6 +670,15 @@ func (v *subSchema) validateObject(currentSubSchema *subSchema, value map[string
 		}
 	}
 
+	// readOnly & writeOnly:
+	if result.state.direction != DIRECTION_NONE {
+		for pk := range value {
+			for _, propertySchema := range currentSubSchema.propertySchemas(pk) {
+				v.validatePropertyDirection(resolvedSchema(propertySchema), pk, value[pk], result, NewJsonContext(pk, context))
+			}
+		}
+	}
+
 	// additionalProperty & patternProperty:
 	if currentSubSchema.additionalProperties != nil {
 
@@ -603,14 +741,14 @@ func (v *subSchema) validateObject(currentSubSchema *subSchema, value map[string
 				if found {
 
 					if pp_has && !pp_match {
-						validationResult := additionalPropertiesSchema.subValidateWithContext(value[pk], context)
+						validationResult := additionalPropertiesSchema.subValidateWithContext(value[pk], NewJsonContext(pk, context), result.state)
 						result.mergeErrors(validationResult)
 					}
 
 				} else {
 
 					if !pp_has || !pp_match {
-						validationResult := additionalPropertiesSchema.subValidateWithContext(value[pk], context)
+						validationResult := additionalPropertiesSchema.subValidateWithContext(value[pk], NewJsonContext(pk, context), result.state)
 						result.mergeErrors(validationResult)
 					}
 
@@ -643,7 +781,52 @@ func (v *subSchema) validateObject(currentSubSchema *subSchema, value map[string
 	result.incrementScore()
 }
 
-func (v *subSchema) validatePatternProperty(currentSubSchema *subSchema, key string, value interface{}, result *Result, context *jsonContext) (has bool, matched bool) {
+// propertySchemas returns the schemas that apply to the property key :
+// its properties schema, the matching patternProperties, or else additionalProperties
+func (v *subSchema) propertySchemas(key string) []*subSchema {
+
+	var schemas []*subSchema
+
+	for _, pSchema := range v.propertiesChildren {
+		if pSchema.property == key {
+			schemas = append(schemas, pSchema)
+		}
+	}
+
+	for pk, pv := range v.patternProperties {
+		if v.patternPropertiesRegexps[pk].MatchString(key) {
+			schemas = append(schemas, pv)
+		}
+	}
+
+	if additionalPropertiesSchema, ok := v.additionalProperties.(*subSchema); ok && len(schemas) == 0 {
+		schemas = append(schemas, additionalPropertiesSchema)
+	}
+
+	return schemas
+}
+
+func (v *subSchema) validatePropertyDirection(propertySchema *subSchema, key string, value interface{}, result *Result, context *JsonContext) {
+
+	if result.state.direction == DIRECTION_REQUEST && propertySchema.readOnly {
+		result.addError(
+			new(ReadOnlyError),
+			context,
+			value,
+			ErrorDetails{"property": key},
+		)
+	}
+	if result.state.direction == DIRECTION_RESPONSE && propertySchema.writeOnly {
+		result.addError(
+			new(WriteOnlyError),
+			context,
+			value,
+			ErrorDetails{"property": key},
+		)
+	}
+}
+
+func (v *subSchema) validatePatternProperty(currentSubSchema *subSchema, key string, value interface{}, result *Result, context *JsonContext) (has bool, matched bool) {
 
 	if internalLogEnabled {
 		internalLog("validatePatternProperty %s", context.String())
@@ -655,10 +838,10 @@ func (v *subSchema) validatePatternProperty(currentSubSchema *subSchema, key str
 	validatedkey := false
 
 	for pk, pv := range currentSubSchema.patternProperties {
-		if matches, _ := regexp.MatchString(pk, key); matches {
+		if currentSubSchema.patternPropertiesRegexps[pk].MatchString(key) {
 			has = true
-			subContext := newJsonContext(key, context)
-			validationResult := pv.subValidateWithContext(value, subContext)
+			subContext := NewJsonContext(key, context)
+			validationResult := pv.subValidateWithContext(value, subContext, result.state)
 			result.mergeErrors(validationResult)
 			if validationResult.Valid() {
 				validatedkey = true
@@ -675,7 +858,7 @@ func (v *subSchema) validatePatternProperty(currentSubSchema *subSchema, key str
 	return has, true
 }
 
-func (v *subSchema) validateString(currentSubSchema *subSchema, value interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateString(currentSubSchema *subSchema, value interface{}, result *Result, context *JsonContext) {
 
 	// Ignore JSON numbers
 	if isJsonNumber(value) {
@@ -729,22 +912,10 @@ func (v *subSchema) validateString(currentSubSchema *subSchema, value interface{
 		}
 	}
 
-	// format
-	if currentSubSchema.format != "" {
-		if !FormatCheckers.IsFormat(currentSubSchema.format, stringValue) {
-			result.addError(
-				new(DoesNotMatchFormatError),
-				context,
-				value,
-				ErrorDetails{"format": currentSubSchema.format},
-			)
-		}
-	}
-
 	result.incrementScore()
 }
 
-func (v *subSchema) validateNumber(currentSubSchema *subSchema, value interface{}, result *Result, context *jsonContext) {
+func (v *subSchema) validateNumber(currentSubSchema *subSchema, value interface{}, result *Result, context *JsonContext) {
 
 	// Ignore non numbers
 	if !isJsonNumber(value) {
@@ -0,0 +1,53 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description      (Unit) Tests for validation.
+//
+// created          18-10-2026
+
+package gojsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdditionalPropertiesErrorContext(t *testing.T) {
+
+	schema, err := NewSchema(NewStringLoader(`{
+		"properties": {"a": {}},
+		"patternProperties": {"^x-": {"type": "string"}},
+		"additionalProperties": {"type": "integer"}
+	}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	result, err := schema.Validate(NewStringLoader(`{"a": "ok", "x-b": "ok", "c": "one"}`))
+	if !assert.Nil(t, err) || !assert.Len(t, result.Errors(), 1) {
+		return
+	}
+
+	// the error is located at the additional property, not at the object
+	assert.Equal(t, "c", result.Errors()[0].Field())
+	assert.Equal(t, "(root).c", result.Errors()[0].Context().String())
+}